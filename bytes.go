@@ -0,0 +1,194 @@
+package veil
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/veil-services/veil-go/detectors"
+)
+
+// maskBuffer holds the per-call state of a mask, reused across calls
+// through maskBuffers.
+type maskBuffer struct {
+	matches []detectors.Match
+	counts  []typeCount
+	tokens  map[string]tokenRef // value -> token, for ConsistentTokenization
+}
+
+type typeCount struct {
+	typ detectors.PIIType
+	n   int
+}
+
+type tokenRef struct {
+	typ detectors.PIIType
+	n   int
+}
+
+// maxPooledMatches bounds the buffers kept in the pool, so one huge input
+// does not pin its memory for the life of the process.
+const maxPooledMatches = 4096
+
+var maskBuffers = sync.Pool{
+	New: func() any { return new(maskBuffer) },
+}
+
+func getMaskBuffer() *maskBuffer {
+	return maskBuffers.Get().(*maskBuffer)
+}
+
+func putMaskBuffer(b *maskBuffer) {
+	if cap(b.matches) > maxPooledMatches {
+		return
+	}
+	// Drop references to the input and metadata
+	clear(b.matches)
+	b.matches = b.matches[:0]
+	b.counts = b.counts[:0]
+	clear(b.tokens)
+	maskBuffers.Put(b)
+}
+
+// next returns the next token number of typ.
+func (b *maskBuffer) next(typ detectors.PIIType) int {
+	for i := range b.counts {
+		if b.counts[i].typ == typ {
+			b.counts[i].n++
+			return b.counts[i].n
+		}
+	}
+	b.counts = append(b.counts, typeCount{typ: typ, n: 1})
+	return 1
+}
+
+// MaskAppend appends src with its PII replaced by tokens to dst and returns
+// the extended buffer, like append. It is the one-way mask of Sanitize for
+// hot paths: nothing is kept for Restore, and once its buffers are warm it
+// does not allocate, except for matches written by the partial and hash
+// strategies. dst and src must not overlap.
+func (v *Veil) MaskAppend(dst, src []byte) []byte {
+	if len(src) == 0 {
+		return dst
+	}
+	e := v.state.Load()
+	b := getMaskBuffer()
+	defer putMaskBuffer(b)
+
+	// Matches alias src; they do not outlive this call
+	input := bytesToString(src)
+	b.matches = e.scanInto(b.matches, input)
+	return e.mask(dst, input, b, nil, false)
+}
+
+// MaskBytes is Mask for byte slices. The masked text is a new slice and the
+// context holds copies of the values, so src may be reused afterwards.
+func (v *Veil) MaskBytes(src []byte) ([]byte, *RestoreContext, error) {
+	ctx := &RestoreContext{Data: make(map[string]string)}
+	if len(src) == 0 {
+		return []byte{}, ctx, nil
+	}
+	e := v.state.Load()
+	b := getMaskBuffer()
+	defer putMaskBuffer(b)
+
+	input := bytesToString(src)
+	b.matches = e.scanInto(b.matches, input)
+	out := e.mask(make([]byte, 0, len(src)), input, b, ctx, false)
+	return out, ctx, nil
+}
+
+// mask appends input to dst with b.matches replaced. With a non-nil ctx,
+// tokens are recorded for Restore; their values are copied unless input is
+// immutable (owned).
+func (e *engine) mask(dst []byte, input string, b *maskBuffer, ctx *RestoreContext, owned bool) []byte {
+	lastIndex := 0
+	for _, m := range b.matches {
+		// Add non-masked text before the match
+		dst = append(dst, input[lastIndex:m.StartIndex]...)
+		lastIndex = m.EndIndex
+
+		// Detectors may ask for a one-way replacement (e.g. coarsened coordinates)
+		if m.Replacement != "" {
+			dst = append(dst, m.Replacement...)
+			continue
+		}
+
+		// One-way strategies write their own text and skip the context
+		if s, ok := e.config.Strategies[m.Type]; ok && s != StrategyToken {
+			dst = appendReplacement(dst, s, m)
+			continue
+		}
+
+		// Determine token
+		if e.config.ConsistentTokenization {
+			if ref, exists := b.tokens[m.Value]; exists {
+				dst = appendToken(dst, ref.typ, ref.n)
+				continue
+			}
+		}
+
+		ref := tokenRef{typ: m.Type, n: b.next(m.Type)}
+		if e.config.ConsistentTokenization {
+			if b.tokens == nil {
+				b.tokens = make(map[string]tokenRef)
+			}
+			b.tokens[m.Value] = ref
+		}
+
+		start := len(dst)
+		dst = appendToken(dst, ref.typ, ref.n)
+		if ctx != nil {
+			value := m.Value
+			if !owned {
+				value = strings.Clone(value)
+			}
+			ctx.Data[string(dst[start:])] = value
+		}
+	}
+
+	// Add remaining string
+	return append(dst, input[lastIndex:]...)
+}
+
+// appendToken appends the token <<TYPE_N>>.
+func appendToken(dst []byte, typ detectors.PIIType, n int) []byte {
+	dst = append(dst, "<<"...)
+	dst = append(dst, typ...)
+	dst = append(dst, '_')
+	dst = strconv.AppendInt(dst, int64(n), 10)
+	return append(dst, ">>"...)
+}
+
+// appendReplacement appends the text written for m under a one-way
+// strategy. Redaction and keep write in place; the others allocate.
+func appendReplacement(dst []byte, s Strategy, m detectors.Match) []byte {
+	switch s {
+	case StrategyRedact:
+		dst = append(dst, "<<"...)
+		dst = append(dst, m.Type...)
+		return append(dst, ">>"...)
+	case StrategyKeep:
+		return append(dst, m.Value...)
+	}
+	return append(dst, s.replacement(m)...)
+}
+
+// bytesToString returns a string sharing b's memory. The string must not be
+// used after b changes.
+func bytesToString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
+// stringToBytes returns the bytes of s without copying them. They must not
+// be modified.
+func stringToBytes(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
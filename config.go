@@ -0,0 +1,1130 @@
+package veil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/veil-services/veil-go/detectors"
+)
+
+// A configuration file enables detectors and sets policies without code
+// changes. It is JSON or YAML (a subset: block mappings and lists, flow
+// lists, quoted and plain scalars, comments):
+//
+//	detectors: [email, br_cpf, global_credit_card, addresses]
+//	locales: [eu]
+//	disabled: [uk_nhs]
+//
+//	settings:
+//	  consistent_tokenization: true
+//	  unicode_normalization: true
+//	  date_locale: pt-BR
+//	  type_priority: [CNPJ, CREDIT_CARD]
+//
+//	patterns:
+//	  - name: account_id
+//	    regex: 'ACC-\d{4}-\d{6}'
+//	    keywords: [account, conta]
+//
+//	dictionaries:
+//	  - name: customers
+//	    terms:
+//	      - Acme Corp
+//	      - term: João Silva
+//	        type: vip
+//
+//	allow:
+//	  EMAIL: [support@example.com]
+//	  CREDIT_CARD: [4242 4242 4242 4242]
+//	  "*": [127.0.0.1]
+//
+//	deny:
+//	  PROJECT: [Falcon]
+//	  IP: [10.20.0.0/16]
+//
+//	allow_patterns:
+//	  EMAIL: ['.+@example\.com']
+//
+//	strategies:
+//	  CREDIT_CARD: partial
+//
+// Detector names are those of RegisteredDetectors plus packs such as
+// "addresses" or "hipaa_safe_harbor"; locales are those of WithLocales. Types are
+// normalized with detectors.NewPIIType ("credit card" is CREDIT_CARD).
+
+// ConfigError reports an invalid configuration, with the line it refers to.
+type ConfigError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ConfigError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("veil: config line %d: %s", e.Line, e.Msg)
+	}
+	return "veil: config: " + e.Msg
+}
+
+// configPacks are the detector packs accepted in the "detectors" list of a
+// configuration file, next to the names of RegisteredDetectors.
+var configPacks = map[string]Option{
+	"device_identifiers":  WithDeviceIdentifiers(),
+	"eu_identifiers":      WithEUIdentifiers(),
+	"crypto_wallets":      WithCryptoWallets(),
+	"vehicle_identifiers": WithVehicleIdentifiers(),
+	"health_identifiers":  WithHealthIdentifiers(),
+	"hipaa_safe_harbor":   WithHIPAASafeHarbor(),
+	"addresses":           WithAddresses(),
+}
+
+// LoadConfig reads a configuration file and returns the option applying it.
+// Files ending in .json are read as JSON, others as YAML unless they start
+// with '{'.
+//
+//	opt, err := veil.LoadConfig("veil.yaml")
+//	v, err := veil.New(opt)
+//	...
+//	opt, err = veil.LoadConfig("veil.yaml") // after the file changed
+//	err = v.Reload(opt)
+func LoadConfig(path string) (Option, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("veil: %w", err)
+	}
+	isJSON := strings.EqualFold(filepath.Ext(path), ".json") || startsWithBrace(data)
+	return parseConfig(data, isJSON)
+}
+
+// FromConfig reads a JSON or YAML configuration (JSON when it starts with
+// '{') and returns the option applying it.
+func FromConfig(r io.Reader) (Option, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("veil: %w", err)
+	}
+	return parseConfig(data, startsWithBrace(data))
+}
+
+func startsWithBrace(data []byte) bool {
+	data = bytes.TrimLeft(data, " \t\r\n\uFEFF")
+	return len(data) > 0 && data[0] == '{'
+}
+
+func parseConfig(data []byte, isJSON bool) (Option, error) {
+	var root *configNode
+	var err error
+	if isJSON {
+		root, err = parseJSONConfig(data)
+	} else {
+		root, err = parseYAMLConfig(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := configOptions(root)
+	if err != nil {
+		return nil, err
+	}
+	return func(c *Config) {
+		for _, opt := range opts {
+			opt(c)
+		}
+	}, nil
+}
+
+// configOptions maps a parsed file onto options.
+func configOptions(root *configNode) ([]Option, error) {
+	if root.kind == scalarNode && root.value == "" {
+		return nil, nil
+	}
+	if root.kind != mapNode {
+		return nil, root.errorf("expected a mapping at the top level")
+	}
+
+	var opts []Option
+	for k, key := range root.keys {
+		node := root.vals[k]
+		var more []Option
+		var err error
+		switch key {
+		case "detectors":
+			more, err = configDetectorOptions(node, true)
+		case "disabled":
+			more, err = configDetectorOptions(node, false)
+		case "locales":
+			more, err = configLocaleOptions(node)
+		case "settings":
+			more, err = configSettingOptions(node)
+		case "patterns":
+			more, err = configPatternOptions(node)
+		case "dictionaries":
+			more, err = configDictionaryOptions(node)
+		case "allow":
+			more, err = configListOptions(node, true)
+		case "deny":
+			more, err = configListOptions(node, false)
+		case "allow_patterns":
+			more, err = configPatternListOptions(node, true)
+		case "deny_patterns":
+			more, err = configPatternListOptions(node, false)
+		case "strategies":
+			more, err = configStrategyOptions(node)
+		default:
+			err = node.keyErrorf("unknown section %q", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, more...)
+	}
+	return opts, nil
+}
+
+func configDetectorOptions(node *configNode, enable bool) ([]Option, error) {
+	names, err := node.stringList()
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]Option, 0, len(names))
+	for i, name := range names {
+		name = strings.ToLower(name)
+		if pack, ok := configPacks[name]; ok && enable {
+			opts = append(opts, pack)
+			continue
+		}
+		if _, ok := LookupDetector(name); !ok {
+			return nil, node.items[i].errorf("unknown detector %q", name)
+		}
+		if enable {
+			opts = append(opts, WithDetectors(name))
+		} else {
+			opts = append(opts, WithoutDetector(name))
+		}
+	}
+	return opts, nil
+}
+
+func configLocaleOptions(node *configNode) ([]Option, error) {
+	locales, err := node.stringList()
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool)
+	for _, info := range RegisteredDetectors() {
+		for _, l := range info.Locales {
+			known[l] = true
+		}
+	}
+	for i, l := range locales {
+		if !known[strings.ToLower(l)] {
+			return nil, node.items[i].errorf("no detectors for locale %q", l)
+		}
+	}
+	return []Option{WithLocales(locales...)}, nil
+}
+
+// configFlags are settings that enable an option when true.
+var configFlags = map[string]Option{
+	"unicode_normalization": WithUnicodeNormalization(),
+	"evasion_resistance":    WithEvasionResistance(),
+	"obfuscated_contacts":   WithObfuscatedContacts(),
+	"card_companions":       WithCardCompanions(),
+	"all_dates":             WithAllDates(),
+	"coarse_coordinates":    WithCoarseCoordinates(),
+	"dsn_users":             WithDSNUsers(),
+	"dsn_hosts":             WithDSNHosts(),
+}
+
+func configSettingOptions(node *configNode) ([]Option, error) {
+	if node.kind != mapNode {
+		return nil, node.errorf("settings must be a mapping")
+	}
+
+	var opts []Option
+	for k, key := range node.keys {
+		val := node.vals[k]
+		if opt, ok := configFlags[key]; ok {
+			on, err := val.bool()
+			if err != nil {
+				return nil, err
+			}
+			if on {
+				opts = append(opts, opt)
+			}
+			continue
+		}
+
+		switch key {
+		case "consistent_tokenization":
+			on, err := val.bool()
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithConsistentTokenization(on))
+		case "overlap":
+			s, err := val.string()
+			if err != nil {
+				return nil, err
+			}
+			strategy, ok := ParseOverlapStrategy(s)
+			if !ok {
+				return nil, val.errorf("unknown overlap strategy %q (want leftmost, longest, score, priority or merge)", s)
+			}
+			opts = append(opts, WithOverlapStrategy(strategy))
+		case "type_priority":
+			types, err := val.stringList()
+			if err != nil {
+				return nil, err
+			}
+			priority := make([]detectors.PIIType, len(types))
+			for i, typ := range types {
+				priority[i] = configType(typ)
+			}
+			opts = append(opts, WithTypePriority(priority...))
+		case "date_locale":
+			s, err := val.string()
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithDateLocale(s))
+		case "name_min_score":
+			f, err := val.float()
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithNameMinScore(float32(f)))
+		case "url_params":
+			params, err := val.stringList()
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithURLParams(params...))
+		case "mrn_patterns":
+			patterns, err := val.stringList()
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithMRN(patterns...))
+		default:
+			return nil, val.keyErrorf("unknown setting %q", key)
+		}
+	}
+	return opts, nil
+}
+
+var configBoundaries = map[string]detectors.Boundary{
+	"word":  detectors.BoundaryWord,
+	"digit": detectors.BoundaryDigit,
+	"space": detectors.BoundarySpace,
+	"none":  detectors.BoundaryNone,
+}
+
+func configPatternOptions(node *configNode) ([]Option, error) {
+	if node.kind != listNode {
+		return nil, node.errorf("patterns must be a list")
+	}
+
+	var opts []Option
+	for _, item := range node.items {
+		if item.kind != mapNode {
+			return nil, item.errorf("a pattern must be a mapping")
+		}
+
+		var name, typ, expr string
+		var popts []detectors.PatternOption
+		for k, key := range item.keys {
+			val := item.vals[k]
+			var err error
+			switch key {
+			case "name":
+				name, err = val.string()
+			case "type":
+				typ, err = val.string()
+			case "regex":
+				expr, err = val.string()
+			case "group":
+				var n int
+				if n, err = val.int(); err == nil {
+					popts = append(popts, detectors.PatternGroup(n))
+				}
+			case "group_name":
+				var s string
+				if s, err = val.string(); err == nil {
+					popts = append(popts, detectors.PatternGroupName(s))
+				}
+			case "validator":
+				var s string
+				if s, err = val.string(); err == nil {
+					popts = append(popts, detectors.PatternValidator(s))
+				}
+			case "keywords":
+				var kws []string
+				if kws, err = val.stringList(); err == nil {
+					popts = append(popts, detectors.PatternKeywords(kws...))
+				}
+			case "window":
+				var n int
+				if n, err = val.int(); err == nil {
+					popts = append(popts, detectors.PatternKeywordWindow(n))
+				}
+			case "boundary":
+				var s string
+				if s, err = val.string(); err == nil {
+					b, ok := configBoundaries[strings.ToLower(s)]
+					if !ok {
+						return nil, val.errorf("unknown boundary %q (want word, digit, space or none)", s)
+					}
+					popts = append(popts, detectors.PatternBoundary(b))
+				}
+			case "score":
+				var f float64
+				if f, err = val.float(); err == nil {
+					popts = append(popts, detectors.PatternScore(float32(f)))
+				}
+			default:
+				err = val.keyErrorf("unknown pattern field %q", key)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if name == "" || expr == "" {
+			return nil, item.errorf("a pattern needs a name and a regex")
+		}
+		if typ == "" {
+			typ = name
+		}
+		d, err := detectors.NewPattern(name, detectors.NewPIIType(typ), expr, popts...)
+		if err != nil {
+			return nil, item.errorf("%v", err)
+		}
+		opts = append(opts, WithCustomDetector(d))
+	}
+	return opts, nil
+}
+
+// configDictionaryFlags are dictionary fields enabling an option when true.
+var configDictionaryFlags = map[string]detectors.DictionaryOption{
+	"case_sensitive": detectors.DictionaryCaseSensitive(),
+	"keep_accents":   detectors.DictionaryKeepAccents(),
+	"partial_words":  detectors.DictionaryPartialWords(),
+}
+
+func configDictionaryOptions(node *configNode) ([]Option, error) {
+	if node.kind != listNode {
+		return nil, node.errorf("dictionaries must be a list")
+	}
+
+	var opts []Option
+	for _, item := range node.items {
+		if item.kind != mapNode {
+			return nil, item.errorf("a dictionary must be a mapping")
+		}
+
+		var name, typ string
+		var entries []detectors.DictionaryEntry
+		var dopts []detectors.DictionaryOption
+		for k, key := range item.keys {
+			val := item.vals[k]
+			var err error
+			switch key {
+			case "name":
+				name, err = val.string()
+			case "type":
+				typ, err = val.string()
+			case "terms":
+				entries, err = configDictionaryTerms(val)
+			case "case_sensitive", "keep_accents", "partial_words":
+				var on bool
+				if on, err = val.bool(); err == nil && on {
+					dopts = append(dopts, configDictionaryFlags[key])
+				}
+			case "score":
+				var f float64
+				if f, err = val.float(); err == nil {
+					dopts = append(dopts, detectors.DictionaryScore(float32(f)))
+				}
+			default:
+				err = val.keyErrorf("unknown dictionary field %q", key)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if name == "" {
+			return nil, item.errorf("a dictionary needs a name")
+		}
+		if typ == "" {
+			typ = name
+		}
+		opts = append(opts, WithDictionary(name, detectors.NewPIIType(typ), entries, dopts...))
+	}
+	return opts, nil
+}
+
+// configDictionaryTerms reads terms written as plain values or as
+// {term, type} mappings.
+func configDictionaryTerms(node *configNode) ([]detectors.DictionaryEntry, error) {
+	if node.kind != listNode {
+		return nil, node.errorf("terms must be a list")
+	}
+	entries := make([]detectors.DictionaryEntry, 0, len(node.items))
+	for _, item := range node.items {
+		if item.kind == scalarNode {
+			entries = append(entries, detectors.DictionaryEntry{Term: item.value})
+			continue
+		}
+		if item.kind != mapNode {
+			return nil, item.errorf("a term must be a value or a {term, type} mapping")
+		}
+		var e detectors.DictionaryEntry
+		for k, key := range item.keys {
+			s, err := item.vals[k].string()
+			if err != nil {
+				return nil, err
+			}
+			switch key {
+			case "term":
+				e.Term = s
+			case "type":
+				e.Type = detectors.NewPIIType(s)
+			default:
+				return nil, item.vals[k].keyErrorf("unknown term field %q", key)
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// configType reads a type key: "*" is AnyType, others go through
+// detectors.NewPIIType.
+func configType(key string) detectors.PIIType {
+	if key == string(AnyType) {
+		return AnyType
+	}
+	return detectors.NewPIIType(key)
+}
+
+func configListOptions(node *configNode, allow bool) ([]Option, error) {
+	if node.kind != mapNode {
+		return nil, node.errorf("expected a mapping of types to value lists")
+	}
+
+	var opts []Option
+	for k, key := range node.keys {
+		values, err := node.vals[k].stringList()
+		if err != nil {
+			return nil, err
+		}
+		typ := configType(key)
+		if allow {
+			opts = append(opts, WithAllow(typ, values...))
+			continue
+		}
+		if typ == AnyType {
+			return nil, node.vals[k].keyErrorf("deny lists need a concrete type, not %q", key)
+		}
+		opts = append(opts, WithDeny(typ, values...))
+	}
+	return opts, nil
+}
+
+func configPatternListOptions(node *configNode, allow bool) ([]Option, error) {
+	if node.kind != mapNode {
+		return nil, node.errorf("expected a mapping of types to expression lists")
+	}
+
+	var opts []Option
+	for k, key := range node.keys {
+		exprs, err := node.vals[k].stringList()
+		if err != nil {
+			return nil, err
+		}
+		typ := configType(key)
+		if !allow && typ == AnyType {
+			return nil, node.vals[k].keyErrorf("deny patterns need a concrete type, not %q", key)
+		}
+		for i, expr := range exprs {
+			if _, err := regexp.Compile(expr); err != nil {
+				return nil, node.vals[k].items[i].errorf("%v", err)
+			}
+			if allow {
+				opts = append(opts, WithAllowPattern(typ, expr))
+			} else {
+				opts = append(opts, WithDenyPattern(typ, expr))
+			}
+		}
+	}
+	return opts, nil
+}
+
+func configStrategyOptions(node *configNode) ([]Option, error) {
+	if node.kind != mapNode {
+		return nil, node.errorf("strategies must be a mapping of types to strategies")
+	}
+
+	var opts []Option
+	for k, key := range node.keys {
+		val := node.vals[k]
+		s, err := val.string()
+		if err != nil {
+			return nil, err
+		}
+		strategy := Strategy(strings.ToLower(s))
+		if !strategy.valid() {
+			return nil, val.errorf("unknown strategy %q (want token, redact, partial, hash or keep)", s)
+		}
+		opts = append(opts, WithStrategy(configType(key), strategy))
+	}
+	return opts, nil
+}
+
+// configNode is a parsed JSON or YAML value with its line.
+type configNode struct {
+	kind    nodeKind
+	line    int
+	keyLine int // line of the key holding this value, if any
+
+	value string        // scalarNode
+	keys  []string      // mapNode, in file order
+	vals  []*configNode // mapNode
+	items []*configNode // listNode
+}
+
+type nodeKind uint8
+
+const (
+	scalarNode nodeKind = iota
+	mapNode
+	listNode
+)
+
+func (n *configNode) errorf(format string, args ...interface{}) error {
+	return &ConfigError{Line: n.line, Msg: fmt.Sprintf(format, args...)}
+}
+
+// keyErrorf reports an error on the line of the key holding n.
+func (n *configNode) keyErrorf(format string, args ...interface{}) error {
+	line := n.keyLine
+	if line == 0 {
+		line = n.line
+	}
+	return &ConfigError{Line: line, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (n *configNode) set(key string, val *configNode, line int) error {
+	for _, k := range n.keys {
+		if k == key {
+			return &ConfigError{Line: line, Msg: fmt.Sprintf("duplicate key %q", key)}
+		}
+	}
+	val.keyLine = line
+	n.keys = append(n.keys, key)
+	n.vals = append(n.vals, val)
+	return nil
+}
+
+func (n *configNode) string() (string, error) {
+	if n.kind != scalarNode {
+		return "", n.errorf("expected a value, got a %s", n.kind)
+	}
+	return n.value, nil
+}
+
+func (n *configNode) bool() (bool, error) {
+	s, err := n.string()
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(s) {
+	case "true", "yes", "on":
+		return true, nil
+	case "false", "no", "off":
+		return false, nil
+	}
+	return false, n.errorf("expected true or false, got %q", s)
+}
+
+func (n *configNode) int() (int, error) {
+	s, err := n.string()
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, n.errorf("expected an integer, got %q", s)
+	}
+	return v, nil
+}
+
+func (n *configNode) float() (float64, error) {
+	s, err := n.string()
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, n.errorf("expected a number, got %q", s)
+	}
+	return v, nil
+}
+
+func (n *configNode) stringList() ([]string, error) {
+	if n.kind == scalarNode && n.value == "" {
+		return nil, nil
+	}
+	if n.kind != listNode {
+		return nil, n.errorf("expected a list, got a %s", n.kind)
+	}
+	values := make([]string, 0, len(n.items))
+	for _, item := range n.items {
+		s, err := item.string()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, s)
+	}
+	return values, nil
+}
+
+func (k nodeKind) String() string {
+	switch k {
+	case mapNode:
+		return "mapping"
+	case listNode:
+		return "list"
+	}
+	return "value"
+}
+
+// JSON
+
+type jsonConfigParser struct {
+	data []byte
+	dec  *json.Decoder
+}
+
+func parseJSONConfig(data []byte) (*configNode, error) {
+	p := &jsonConfigParser{data: data, dec: json.NewDecoder(bytes.NewReader(data))}
+	p.dec.UseNumber()
+
+	root, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.dec.Token(); err != io.EOF {
+		return nil, &ConfigError{Line: p.line(), Msg: "unexpected data after the top-level value"}
+	}
+	return root, nil
+}
+
+func (p *jsonConfigParser) line() int {
+	off := int(p.dec.InputOffset())
+	if off > len(p.data) {
+		off = len(p.data)
+	}
+	return 1 + bytes.Count(p.data[:off], []byte{'\n'})
+}
+
+func (p *jsonConfigParser) fail(err error) error {
+	var syntax *json.SyntaxError
+	if errors.As(err, &syntax) {
+		off := int(syntax.Offset)
+		if off > len(p.data) {
+			off = len(p.data)
+		}
+		return &ConfigError{Line: 1 + bytes.Count(p.data[:off], []byte{'\n'}), Msg: syntax.Error()}
+	}
+	if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+		return &ConfigError{Line: 1 + bytes.Count(p.data, []byte{'\n'}), Msg: "unexpected end of JSON"}
+	}
+	return &ConfigError{Line: p.line(), Msg: err.Error()}
+}
+
+func (p *jsonConfigParser) value() (*configNode, error) {
+	tok, err := p.dec.Token()
+	if err != nil {
+		return nil, p.fail(err)
+	}
+	node := &configNode{line: p.line()}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		if t == '[' {
+			node.kind = listNode
+			for p.dec.More() {
+				item, err := p.value()
+				if err != nil {
+					return nil, err
+				}
+				node.items = append(node.items, item)
+			}
+		} else {
+			node.kind = mapNode
+			for p.dec.More() {
+				keyTok, err := p.dec.Token()
+				if err != nil {
+					return nil, p.fail(err)
+				}
+				key, _ := keyTok.(string)
+				line := p.line()
+				val, err := p.value()
+				if err != nil {
+					return nil, err
+				}
+				if err := node.set(key, val, line); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if _, err := p.dec.Token(); err != nil {
+			return nil, p.fail(err)
+		}
+	case string:
+		node.value = t
+	case json.Number:
+		node.value = t.String()
+	case bool:
+		node.value = strconv.FormatBool(t)
+	case nil:
+		// null is an empty value
+	}
+	return node, nil
+}
+
+// YAML subset
+
+type yamlLine struct {
+	line   int
+	indent int
+	text   string
+}
+
+type yamlConfigParser struct {
+	lines []yamlLine
+	i     int
+}
+
+func parseYAMLConfig(data []byte) (*configNode, error) {
+	lines, err := splitYAMLLines(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return &configNode{line: 1}, nil
+	}
+
+	p := &yamlConfigParser{lines: lines}
+	if lines[0].indent != 0 {
+		return nil, &ConfigError{Line: lines[0].line, Msg: "unexpected indentation"}
+	}
+	root, err := p.block(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.i < len(p.lines) {
+		return nil, &ConfigError{Line: p.lines[p.i].line, Msg: "unexpected indentation"}
+	}
+	return root, nil
+}
+
+// splitYAMLLines drops comments and blank lines and measures indentation.
+func splitYAMLLines(data []byte) ([]yamlLine, error) {
+	data = bytes.TrimPrefix(data, []byte("\uFEFF"))
+	var lines []yamlLine
+	for n, raw := range strings.Split(string(data), "\n") {
+		raw = strings.TrimRight(stripYAMLComment(strings.TrimSuffix(raw, "\r")), " \t")
+		text := strings.TrimLeft(raw, " ")
+		if text == "" {
+			continue
+		}
+		if text[0] == '\t' {
+			return nil, &ConfigError{Line: n + 1, Msg: "tabs are not allowed for indentation"}
+		}
+		if text == "---" || text == "..." {
+			if len(lines) == 0 || text == "..." {
+				continue
+			}
+			return nil, &ConfigError{Line: n + 1, Msg: "multiple documents are not supported"}
+		}
+		lines = append(lines, yamlLine{line: n + 1, indent: len(raw) - len(text), text: text})
+	}
+	return lines, nil
+}
+
+// stripYAMLComment cuts a '#' comment that is not inside quotes.
+func stripYAMLComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || s[i-1] == ' ' || s[i-1] == '[' || s[i-1] == ',' || s[i-1] == '-' || s[i-1] == ':' {
+				quote = c
+			}
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+func isYAMLListItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+func (p *yamlConfigParser) block(indent int) (*configNode, error) {
+	if isYAMLListItem(p.lines[p.i].text) {
+		return p.list(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlConfigParser) mapping(indent int) (*configNode, error) {
+	node := &configNode{kind: mapNode, line: p.lines[p.i].line}
+
+	for p.i < len(p.lines) && p.lines[p.i].indent == indent {
+		l := p.lines[p.i]
+		if isYAMLListItem(l.text) {
+			return nil, &ConfigError{Line: l.line, Msg: "unexpected list item in a mapping"}
+		}
+		key, rest, ok, err := splitYAMLKey(l.text, l.line)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &ConfigError{Line: l.line, Msg: fmt.Sprintf("expected \"key: value\", got %q", l.text)}
+		}
+		p.i++
+
+		var val *configNode
+		switch {
+		case rest != "":
+			val, err = parseYAMLInline(rest, l.line)
+		case p.i < len(p.lines) && p.lines[p.i].indent > indent:
+			val, err = p.block(p.lines[p.i].indent)
+		case p.i < len(p.lines) && p.lines[p.i].indent == indent && isYAMLListItem(p.lines[p.i].text):
+			// "key:\n- item" lists may sit at the key's indentation
+			val, err = p.list(indent)
+		default:
+			val = &configNode{line: l.line}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := node.set(key, val, l.line); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.i < len(p.lines) && p.lines[p.i].indent > indent {
+		return nil, &ConfigError{Line: p.lines[p.i].line, Msg: "unexpected indentation"}
+	}
+	return node, nil
+}
+
+func (p *yamlConfigParser) list(indent int) (*configNode, error) {
+	node := &configNode{kind: listNode, line: p.lines[p.i].line}
+
+	for p.i < len(p.lines) && p.lines[p.i].indent == indent && isYAMLListItem(p.lines[p.i].text) {
+		l := p.lines[p.i]
+		content := strings.TrimLeft(l.text[1:], " ")
+
+		var item *configNode
+		var err error
+		switch {
+		case content == "":
+			p.i++
+			if p.i < len(p.lines) && p.lines[p.i].indent > indent {
+				item, err = p.block(p.lines[p.i].indent)
+			} else {
+				item = &configNode{line: l.line}
+			}
+		case isYAMLMappingItem(content, l.line):
+			// "- key: value": the mapping continues at the column of "key"
+			p.lines[p.i] = yamlLine{line: l.line, indent: indent + len(l.text) - len(content), text: content}
+			item, err = p.mapping(p.lines[p.i].indent)
+		default:
+			p.i++
+			item, err = parseYAMLInline(content, l.line)
+		}
+		if err != nil {
+			return nil, err
+		}
+		node.items = append(node.items, item)
+	}
+
+	if p.i < len(p.lines) && p.lines[p.i].indent > indent {
+		return nil, &ConfigError{Line: p.lines[p.i].line, Msg: "unexpected indentation"}
+	}
+	return node, nil
+}
+
+func isYAMLMappingItem(content string, line int) bool {
+	if content[0] == '[' || content[0] == '{' {
+		return false
+	}
+	_, _, ok, err := splitYAMLKey(content, line)
+	return ok && err == nil
+}
+
+// splitYAMLKey splits "key: rest". Keys may be quoted.
+func splitYAMLKey(text string, line int) (key, rest string, ok bool, err error) {
+	if text[0] == '"' || text[0] == '\'' {
+		key, end, err := readYAMLQuoted(text, line)
+		if err != nil {
+			return "", "", false, err
+		}
+		after := text[end:]
+		if after != ":" && !strings.HasPrefix(after, ": ") {
+			return "", "", false, nil
+		}
+		return key, strings.TrimSpace(after[1:]), true, nil
+	}
+
+	i := strings.Index(text, ": ")
+	if i < 0 && strings.HasSuffix(text, ":") {
+		i = len(text) - 1
+	}
+	if i <= 0 {
+		return "", "", false, nil
+	}
+	return strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:]), true, nil
+}
+
+// parseYAMLInline parses the value written after "key:" or "- ".
+func parseYAMLInline(s string, line int) (*configNode, error) {
+	switch s[0] {
+	case '[':
+		return parseYAMLFlowList(s, line)
+	case '{':
+		if strings.TrimSpace(s[1:]) == "}" {
+			return &configNode{kind: mapNode, line: line}, nil
+		}
+		return nil, &ConfigError{Line: line, Msg: "flow mappings ({a: b}) are not supported, use a block mapping"}
+	case '|', '>':
+		return nil, &ConfigError{Line: line, Msg: "block scalars are not supported, use a quoted string"}
+	case '&', '*', '!':
+		return nil, &ConfigError{Line: line, Msg: "anchors, aliases and tags are not supported"}
+	}
+	value, err := parseYAMLScalar(s, line)
+	if err != nil {
+		return nil, err
+	}
+	return &configNode{line: line, value: value}, nil
+}
+
+func parseYAMLFlowList(s string, line int) (*configNode, error) {
+	node := &configNode{kind: listNode, line: line}
+	if !strings.HasSuffix(s, "]") {
+		return nil, &ConfigError{Line: line, Msg: "unterminated list, missing ']'"}
+	}
+	body := strings.TrimSpace(s[1 : len(s)-1])
+	for body != "" {
+		var value string
+		if body[0] == '"' || body[0] == '\'' {
+			v, end, err := readYAMLQuoted(body, line)
+			if err != nil {
+				return nil, err
+			}
+			value, body = v, strings.TrimSpace(body[end:])
+		} else {
+			end := strings.IndexByte(body, ',')
+			if end < 0 {
+				end = len(body)
+			}
+			if strings.ContainsAny(body[:end], "[]{}") {
+				return nil, &ConfigError{Line: line, Msg: "nested flow collections are not supported"}
+			}
+			v, err := parseYAMLScalar(strings.TrimSpace(body[:end]), line)
+			if err != nil {
+				return nil, err
+			}
+			value, body = v, body[end:]
+		}
+		node.items = append(node.items, &configNode{line: line, value: value})
+
+		if body == "" {
+			break
+		}
+		if body[0] != ',' {
+			return nil, &ConfigError{Line: line, Msg: fmt.Sprintf("expected ',' in list, got %q", body)}
+		}
+		body = strings.TrimSpace(body[1:])
+	}
+	return node, nil
+}
+
+func parseYAMLScalar(s string, line int) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	if s[0] == '"' || s[0] == '\'' {
+		value, end, err := readYAMLQuoted(s, line)
+		if err != nil {
+			return "", err
+		}
+		if strings.TrimSpace(s[end:]) != "" {
+			return "", &ConfigError{Line: line, Msg: fmt.Sprintf("unexpected text after quoted string: %q", s[end:])}
+		}
+		return value, nil
+	}
+	if s == "~" || s == "null" {
+		return "", nil
+	}
+	return s, nil
+}
+
+// readYAMLQuoted reads the quoted string at the start of s and returns it
+// unescaped with the offset after the closing quote.
+func readYAMLQuoted(s string, line int) (string, int, error) {
+	quote := s[0]
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '\'' && c == '\'':
+			if i+1 < len(s) && s[i+1] == '\'' {
+				sb.WriteByte('\'')
+				i++
+				continue
+			}
+			return sb.String(), i + 1, nil
+		case quote == '"' && c == '"':
+			return sb.String(), i + 1, nil
+		case quote == '"' && c == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case '"', '\\', '/':
+				sb.WriteByte(s[i])
+			default:
+				return "", 0, &ConfigError{Line: line, Msg: fmt.Sprintf("unsupported escape \\%c in double-quoted string", s[i])}
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", 0, &ConfigError{Line: line, Msg: "unterminated quoted string"}
+}
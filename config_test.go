@@ -0,0 +1,192 @@
+package veil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	input := "account ACC-2024-000123: mail support@acme.com and john@acme.com, CPF 111.444.777-35, " +
+		"card 4111 1111 1111 1111 from 127.0.0.1 and 10.0.0.7 about Falcon"
+	want := "account <<ACCOUNT_ID_1>>: mail support@acme.com and <<EMAIL_1>>, CPF <<CPF>>, " +
+		"card **** **** **** 1111 from 127.0.0.1 and <<IP_1>> about <<PROJECT_1>>"
+
+	for _, path := range []string{"testdata/config.yaml", "testdata/config.json"} {
+		t.Run(filepath.Ext(path), func(t *testing.T) {
+			opt, err := LoadConfig(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v, err := New(opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			masked, ctx, _ := v.Mask(input)
+			if masked != want {
+				t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+			}
+
+			restored, _ := v.Restore(masked, ctx)
+			wantRestored := strings.Replace(input, "111.444.777-35", "<<CPF>>", 1)
+			wantRestored = strings.Replace(wantRestored, "4111 1111 1111 1111", "**** **** **** 1111", 1)
+			if restored != wantRestored {
+				t.Errorf("Restore failed: %s", restored)
+			}
+		})
+	}
+}
+
+func TestFromConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		line   int
+	}{
+		{"Unknown Section", "detectors: [email]\nmasks:\n  - x\n", 2},
+		{"Unknown Detector", "detectors:\n  - email\n  - br_rg\n", 3},
+		{"Unknown Setting", "settings:\n  consistent_tokenization: true\n  colour: red\n", 3},
+		{"Bad Bool", "settings:\n  unicode_normalization: maybe\n", 2},
+		{"Bad Regex", "patterns:\n  - name: x\n    regex: 'a(b'\n", 2},
+		{"Missing Regex", "patterns:\n  - name: x\n", 2},
+		{"Unknown Pattern Field", "patterns:\n  - name: x\n    regex: x\n    flags: i\n", 4},
+		{"Unknown Validator", "patterns:\n  - name: x\n    regex: x\n    validator: crc\n", 2},
+		{"Unknown Strategy", "strategies:\n  EMAIL: scramble\n", 2},
+		{"Deny Any Type", "deny:\n  \"*\": [x]\n", 2},
+		{"Bad Indentation", "detectors:\n  - email\n    - cpf\n", 3},
+		{"Tab Indentation", "detectors:\n\t- email\n", 2},
+		{"Unterminated Quote", "detectors: [email]\n\nsettings:\n  date_locale: \"pt-BR\n", 4},
+		{"Duplicate Key", "detectors: [email]\ndetectors: [br_cpf]\n", 2},
+		{"Unknown Dictionary Field", "dictionaries:\n  - name: x\n    words: [a]\n", 3},
+		{"Bad Allow Pattern", "allow_patterns:\n  EMAIL:\n    - '.+@x\\.com'\n    - 'a('\n", 4},
+		{"Deny Pattern Any Type", "deny_patterns:\n  \"*\": [x]\n", 2},
+		{"Unknown Locale", "locales: [br, xx]\n", 1},
+		{"Unknown Disabled", "disabled:\n  - nope\n", 2},
+		{"Unknown Overlap", "settings:\n  overlap: random\n", 2},
+		{"Anchors", "detectors: &d [email]\n", 1},
+		{"JSON Syntax", "{\n  \"detectors\": [\"email\",]\n}\n", 2},
+		{"JSON Unknown Detector", "{\n  \"detectors\": [\n    \"email\",\n    \"nope\"\n  ]\n}", 4},
+		{"JSON Not A List", "{\n  \"detectors\": \"email\"\n}", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FromConfig(strings.NewReader(tt.config))
+			var cerr *ConfigError
+			if !errors.As(err, &cerr) {
+				t.Fatalf("expected a *ConfigError, got %v", err)
+			}
+			if cerr.Line != tt.line {
+				t.Errorf("expected line %d, got %d (%v)", tt.line, cerr.Line, err)
+			}
+		})
+	}
+}
+
+func TestFromConfig_YAMLForms(t *testing.T) {
+	config := `
+---
+detectors:            # flow and block lists mix
+- email
+- "br_cpf"
+- global_ipv4
+locales: [uk]
+disabled: [global_ipv4, uk_nhs]
+settings:
+  date_locale: 'en-US'  # quoted with comment
+  url_params: []
+  type_priority: [cpf, email]
+dictionaries:
+  - name: customers
+    type: customer
+    terms:
+      - Acme Corp
+      - term: João Silva
+        type: vip
+patterns:
+-   name: ticket
+    regex: "T#\\d{4}"
+    boundary: none
+    score: 0.8
+`
+	opt, err := FromConfig(strings.NewReader(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := New(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	masked, _, _ := v.Mask("ticket T#1234 for john@example.com, CPF 111.444.777-35, joao silva of acme corp, NINO AB123456C, ip 10.0.0.7")
+	want := "ticket <<TICKET_1>> for <<EMAIL_1>>, CPF <<CPF_1>>, <<VIP_1>> of <<CUSTOMER_1>>, NINO <<NINO_1>>, ip 10.0.0.7"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+}
+
+func TestVeil_Reload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "veil.yaml")
+	if err := os.WriteFile(path, []byte("detectors: [email]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	opt, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, _ := New(opt)
+
+	input := "john@example.com 111.444.777-35"
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				// Either configuration, never a mix of both
+				masked, _, _ := v.Mask(input)
+				if masked != "<<EMAIL_1>> 111.444.777-35" && masked != "<<EMAIL_1>> <<CPF_1>>" {
+					t.Errorf("unexpected mask during reload: %s", masked)
+					return
+				}
+			}
+		}()
+	}
+
+	if err := os.WriteFile(path, []byte("detectors: [email, br_cpf]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	opt, err = LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Reload(opt); err != nil {
+		t.Fatal(err)
+	}
+	close(stop)
+	wg.Wait()
+
+	if masked, _, _ := v.Mask(input); masked != "<<EMAIL_1>> <<CPF_1>>" {
+		t.Errorf("reload not applied: %s", masked)
+	}
+
+	// A bad configuration leaves the running one in place
+	if err := v.Reload(WithPattern("x", "X", "a(b")); err == nil {
+		t.Error("expected an error for an invalid reload")
+	}
+	if masked, _, _ := v.Mask(input); masked != "<<EMAIL_1>> <<CPF_1>>" {
+		t.Errorf("failed reload changed the configuration: %s", masked)
+	}
+}
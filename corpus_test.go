@@ -39,6 +39,32 @@ func TestCorpus(t *testing.T) {
 		veil.WithIP(),
 		veil.WithPhone(),
 		veil.WithUUID(),
+		veil.WithDeviceIdentifiers(),
+		veil.WithCUIT(),
+		veil.WithRUT(),
+		veil.WithRFC(),
+		veil.WithCURP(),
+		veil.WithNIT(),
+		veil.WithRUC(),
+		veil.WithEUIdentifiers(),
+		veil.WithAadhaar(),
+		veil.WithPAN(),
+		veil.WithNRIC(),
+		veil.WithTFN(),
+		veil.WithMedicare(),
+		veil.WithCryptoWallets(),
+		veil.WithURLs(),
+		veil.WithDSN(),
+		veil.WithDateOfBirth(),
+		veil.WithAddresses(),
+		veil.WithNames(),
+		veil.WithCoordinates(),
+		veil.WithVehicleIdentifiers(),
+		veil.WithHealthIdentifiers(),
+		veil.WithICD10(),
+		veil.WithObfuscatedContacts(),
+		veil.WithUnicodeNormalization(),
+		veil.WithEvasionResistance(),
 	)
 	if err != nil {
 		t.Fatalf("Failed to init veil: %v", err)
@@ -54,7 +80,7 @@ func TestCorpus(t *testing.T) {
 			// Check found token count
 			foundCount := len(ctx.Data)
 			if foundCount != tc.ExpectedPIICount {
-				t.Errorf("[%s] Expected %d PIIs, found %d.\nInput: %s\nMasked: %s\nCtx: %v", 
+				t.Errorf("[%s] Expected %d PIIs, found %d.\nInput: %s\nMasked: %s\nCtx: %v",
 					tc.ID, tc.ExpectedPIICount, foundCount, tc.Input, masked, ctx.Data)
 			}
 
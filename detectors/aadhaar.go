@@ -0,0 +1,98 @@
+package detectors
+
+type AadhaarDetector struct{}
+
+func (d *AadhaarDetector) Name() string {
+	return "in_aadhaar"
+}
+
+func (d *AadhaarDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *AadhaarDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *AadhaarDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [12]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		end, ok := readFixedDigits(input, i, digits[:], validAadhaarSeparator)
+		if ok && isValidAadhaarBytes(digits[:]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeAadhaar,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewAadhaarDetector detects Indian Aadhaar numbers (2345 6789 0124).
+func NewAadhaarDetector() Detector {
+	return &AadhaarDetector{}
+}
+
+func validAadhaarSeparator(c byte, count int) bool {
+	return (c == ' ' || c == '-') && (count == 4 || count == 8)
+}
+
+// isValidAadhaarBytes rejects the reserved 0/1 leading digits and checks the
+// Verhoeff check digit.
+func isValidAadhaarBytes(aadhaar []byte) bool {
+	if aadhaar[0] < '2' {
+		return false
+	}
+	return isValidVerhoeffBytes(aadhaar)
+}
+
+// Verhoeff dihedral group D5 multiplication and permutation tables.
+var (
+	verhoeffD = [10][10]byte{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	verhoeffP = [8][10]byte{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+)
+
+// isValidVerhoeffBytes implements the Verhoeff algorithm without allocations.
+func isValidVerhoeffBytes(number []byte) bool {
+	var c byte
+	for i := 0; i < len(number); i++ {
+		n := number[len(number)-1-i] - '0'
+		c = verhoeffD[c][verhoeffP[i%8][n]]
+	}
+	return c == 0
+}
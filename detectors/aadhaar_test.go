@@ -0,0 +1,61 @@
+package detectors
+
+import "testing"
+
+func TestAadhaarDetector(t *testing.T) {
+	d := NewAadhaarDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Spaced", "Aadhaar 2345 6789 0124 verified.", 1},
+		{"Valid Plain", "UID: 499118345232", 1},
+		{"Valid Dashed", "UID 2345-6789-0124", 1},
+
+		// Near Misses
+		{"Invalid Verhoeff", "Aadhaar 2345 6789 0125", 0},
+		{"Reserved Leading Digit", "Aadhaar 1345 6789 0124", 0},
+		{"Wrong Grouping", "Aadhaar 234 56789 0124", 0},
+		{"Too Long", "Aadhaar 23456789012400", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "Aadhaar 2345 6789 0124 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzAadhaar -fuzztime=10s
+func FuzzAadhaarDetector(f *testing.F) {
+	d := NewAadhaarDetector()
+
+	f.Add("2345 6789 0124")
+	f.Add("499118345232")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
+
+func TestVerhoeff_ZeroAlloc(t *testing.T) {
+	number := []byte("234567890124")
+	allocs := testing.AllocsPerRun(100, func() {
+		if !isValidVerhoeffBytes(number) {
+			t.Fatal("expected valid Verhoeff number")
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("expected zero allocations, got %.1f", allocs)
+	}
+}
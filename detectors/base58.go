@@ -0,0 +1,87 @@
+package detectors
+
+import "crypto/sha256"
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func base58Value(b byte) int {
+	for k := 0; k < len(base58Alphabet); k++ {
+		if base58Alphabet[k] == b {
+			return k
+		}
+	}
+	return -1
+}
+
+func isBase58Char(b byte) bool {
+	return isAlnumChar(b) && b != '0' && b != 'O' && b != 'I' && b != 'l'
+}
+
+// decodeBase58 decodes s into out, right-aligned and big-endian, and returns
+// the decoded length including the zero bytes encoded as leading '1's. It
+// fails when s holds a character outside the alphabet or does not fit in out.
+func decodeBase58(s string, out []byte) (int, bool) {
+	for k := range out {
+		out[k] = 0
+	}
+	for i := 0; i < len(s); i++ {
+		carry := base58Value(s[i])
+		if carry < 0 {
+			return 0, false
+		}
+		for k := len(out) - 1; k >= 0; k-- {
+			carry += 58 * int(out[k])
+			out[k] = byte(carry)
+			carry >>= 8
+		}
+		if carry != 0 {
+			return 0, false
+		}
+	}
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == '1' {
+		zeros++
+	}
+	first := 0
+	for first < len(out) && out[first] == 0 {
+		first++
+	}
+	size := zeros + len(out) - first
+	if size > len(out) {
+		return 0, false
+	}
+	return size, true
+}
+
+// isValidBase58Check decodes a 25-byte Base58Check payload (version byte,
+// 20-byte hash, 4-byte checksum) and verifies the double-SHA256 checksum.
+// It returns the version byte.
+func isValidBase58Check(s string) (byte, bool) {
+	var buf [25]byte
+	if size, ok := decodeBase58(s, buf[:]); !ok || size != len(buf) {
+		return 0, false
+	}
+	first := sha256.Sum256(buf[:21])
+	second := sha256.Sum256(first[:])
+	for k := 0; k < 4; k++ {
+		if second[k] != buf[21+k] {
+			return 0, false
+		}
+	}
+	return buf[0], true
+}
+
+// base58Run returns the end of the run of Base58 characters starting at i, or
+// -1 when the run is glued to other alphanumeric characters (0, O, I, l) that
+// make it something other than a standalone address.
+func base58Run(input string, i int) int {
+	j := i
+	for j < len(input) && isBase58Char(input[j]) {
+		j++
+	}
+	if j < len(input) && isAlnumChar(input[j]) {
+		return -1
+	}
+	return j
+}
@@ -0,0 +1,178 @@
+package detectors
+
+type BitcoinDetector struct{}
+
+func (d *BitcoinDetector) Name() string {
+	return "crypto_bitcoin"
+}
+
+func (d *BitcoinDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *BitcoinDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *BitcoinDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c != '1' && c != '3' && c != 'b' && c != 'B' {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		var end int
+		var ok bool
+		if c == 'b' || c == 'B' {
+			end, ok = matchBech32Address(input, i)
+		} else {
+			end, ok = matchBase58Address(input, i)
+		}
+		if ok {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeBitcoinAddress,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewBitcoinDetector detects mainnet Bitcoin addresses: legacy P2PKH (1...)
+// and P2SH (3...) with a valid Base58Check checksum, and SegWit bc1 addresses
+// with a valid Bech32 (v0) or Bech32m (v1+) checksum.
+func NewBitcoinDetector() Detector {
+	return &BitcoinDetector{}
+}
+
+func matchBase58Address(s string, start int) (int, bool) {
+	end := base58Run(s, start)
+	if end < 0 || end-start < 26 || end-start > 35 {
+		return 0, false
+	}
+	version, ok := isValidBase58Check(s[start:end])
+	if !ok || (version != 0x00 && version != 0x05) {
+		return 0, false
+	}
+	return end, true
+}
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+func bech32Value(b byte) int {
+	if b >= 'A' && b <= 'Z' {
+		b += 'a' - 'A'
+	}
+	for k := 0; k < len(bech32Charset); k++ {
+		if bech32Charset[k] == b {
+			return k
+		}
+	}
+	return -1
+}
+
+func bech32Polymod(chk uint32, v byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	top := chk >> 25
+	chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+	for k := 0; k < 5; k++ {
+		if (top>>k)&1 == 1 {
+			chk ^= gen[k]
+		}
+	}
+	return chk
+}
+
+// matchBech32Address validates a "bc1" SegWit address (BIP 173 / BIP 350).
+func matchBech32Address(s string, start int) (int, bool) {
+	if start+3 > len(s) || (s[start+1] != 'c' && s[start+1] != 'C') || s[start+2] != '1' {
+		return 0, false
+	}
+
+	end := start + 3
+	lower, upper := false, false
+	for end < len(s) && isAlnumChar(s[end]) {
+		switch c := s[end]; {
+		case c >= 'a' && c <= 'z':
+			lower = true
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		}
+		end++
+	}
+	// Mixed case is invalid; the "bc" prefix counts too.
+	for _, c := range [...]byte{s[start], s[start+1]} {
+		if c >= 'a' && c <= 'z' {
+			lower = true
+		} else {
+			upper = true
+		}
+	}
+	if lower && upper {
+		return 0, false
+	}
+
+	data := s[start+3 : end]
+	// Witness version + at least 2 program bytes + 6 checksum chars; 90 max.
+	if len(data) < 6+1+4 || end-start > 90 {
+		return 0, false
+	}
+
+	// HRP expansion for "bc": high bits, separator, low bits
+	chk := uint32(1)
+	for _, v := range [...]byte{'b' >> 5, 'c' >> 5, 0, 'b' & 31, 'c' & 31} {
+		chk = bech32Polymod(chk, v)
+	}
+	for k := 0; k < len(data); k++ {
+		v := bech32Value(data[k])
+		if v < 0 {
+			return 0, false
+		}
+		chk = bech32Polymod(chk, byte(v))
+	}
+
+	version := bech32Value(data[0])
+	switch {
+	case version == 0 && chk != bech32Const:
+		return 0, false
+	case version > 16:
+		return 0, false
+	case version > 0 && chk != bech32mConst:
+		return 0, false
+	}
+
+	// Regroup the 5-bit program into bytes; leftover padding must be zero.
+	acc, nbits, length := 0, 0, 0
+	for k := 1; k < len(data)-6; k++ {
+		acc = acc<<5 | bech32Value(data[k])
+		nbits += 5
+		if nbits >= 8 {
+			nbits -= 8
+			length++
+		}
+	}
+	if nbits >= 5 || acc&((1<<nbits)-1) != 0 {
+		return 0, false
+	}
+	if length < 2 || length > 40 || (version == 0 && length != 20 && length != 32) {
+		return 0, false
+	}
+
+	return end, true
+}
@@ -0,0 +1,60 @@
+package detectors
+
+import "testing"
+
+func TestBitcoinDetector(t *testing.T) {
+	d := NewBitcoinDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"P2PKH", "Send to 1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2 please", 1},
+		{"P2SH", "Multisig 3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", 1},
+		{"Bech32 v0", "Pay bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", 1},
+		{"Bech32 Uppercase", "Pay BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", 1},
+		{"Bech32m Taproot", "bc1p5d7rjq7g6rdk2yhzks9smlaqtedr4dekq08ge8ztwac72sfr9rusxg3297", 1},
+		{"Two Addresses", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2, 3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", 2},
+
+		// Invalid Cases
+		{"Bad Checksum", "Send to 1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3", 0},
+		{"Bad Bech32 Checksum", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", 0},
+		{"Mixed Case Bech32", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kV8f3t4", 0},
+		{"Mixed Case Bech32 Prefix Bc", "Bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", 0},
+		{"Mixed Case Bech32 Prefix bC", "bC1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", 0},
+		{"Uppercase Prefix Lowercase Data", "BC1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", 0},
+		{"Bech32 With v0 Const On v1", "bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7k7grplx", 0},
+		{"Testnet Address", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", 0},
+		{"Plain Number", "Order 1234567890123456789012345678", 0},
+
+		// Noise & Boundary
+		{"Glued Prefix", "x1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 0},
+		{"Sentence End", "Address: 1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2.", 1},
+		{"Unicode Noise", "BTC 🚀 3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzBitcoin -fuzztime=10s
+func FuzzBitcoinDetector(f *testing.F) {
+	d := NewBitcoinDetector()
+
+	f.Add("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2")
+	f.Add("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+	f.Add("bc1")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
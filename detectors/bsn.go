@@ -0,0 +1,66 @@
+package detectors
+
+type BSNDetector struct{}
+
+func (d *BSNDetector) Name() string {
+	return "nl_bsn"
+}
+
+func (d *BSNDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *BSNDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *BSNDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [9]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		end, ok := readFixedDigits(input, i, digits[:], validBSNSeparator)
+		if ok && isValidBSNBytes(digits[:]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeBSN,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewBSNDetector detects Dutch citizen service numbers (BSN), written as
+// 111222333 or 1112.22.333.
+func NewBSNDetector() Detector {
+	return &BSNDetector{}
+}
+
+func validBSNSeparator(c byte, count int) bool {
+	return c == '.' && (count == 4 || count == 6)
+}
+
+// isValidBSNBytes implements the "elfproef": weights 9..2 and -1 for the
+// last digit must sum to a multiple of 11.
+func isValidBSNBytes(bsn []byte) bool {
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(bsn[i]-'0') * (9 - i)
+	}
+	sum -= int(bsn[8] - '0')
+
+	return sum != 0 && sum%11 == 0
+}
@@ -0,0 +1,48 @@
+package detectors
+
+import "testing"
+
+func TestBSNDetector(t *testing.T) {
+	d := NewBSNDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Plain", "BSN 111222333 geregistreerd.", 1},
+		{"Valid Dotted", "BSN: 1234.56.782", 1},
+
+		// Near Misses
+		{"Fails Eleven Test", "BSN 123456789", 0},
+		{"All Zeros", "BSN 000000000", 0},
+		{"Ten Digits", "BSN 1112223330", 0},
+		{"Wrong Grouping", "BSN 123.456.782", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "BSN 111222333 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzBSN -fuzztime=10s
+func FuzzBSNDetector(f *testing.F) {
+	d := NewBSNDetector()
+
+	f.Add("111222333")
+	f.Add("1234.56.")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,106 @@
+package detectors
+
+// CAPostalCodeDetector finds Canadian postal codes (K1A 0B1). Codes written
+// without a space or in lowercase need a "postal code" / "code postal"
+// keyword in front.
+type CAPostalCodeDetector struct{}
+
+func (d *CAPostalCodeDetector) Name() string {
+	return "ca_postal_code"
+}
+
+var caPostalKeywords = []string{"postal code", "postal", "code postal"}
+
+func (d *CAPostalCodeDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *CAPostalCodeDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *CAPostalCodeDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+6 <= len(input); i++ {
+		if !isLetter(input[i]) || (i > 0 && isAlnumChar(input[i-1])) {
+			continue
+		}
+
+		end, strict, ok := matchCAPostalCode(input, i)
+		if !ok {
+			continue
+		}
+		score := float32(0.9)
+		if keywordBefore(input, i, postalKeywordWindow, caPostalKeywords) != "" {
+			score = 1.0
+		} else if !strict {
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeCAPostalCode,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewCAPostalCodeDetector() Detector {
+	return &CAPostalCodeDetector{}
+}
+
+// isCAPostalLetter reports whether c may appear in a postal code; D, F, I,
+// O, Q and U never do, and W and Z never start one.
+func isCAPostalLetter(c byte, first bool) bool {
+	switch c {
+	case 'D', 'F', 'I', 'O', 'Q', 'U':
+		return false
+	case 'W', 'Z':
+		return !first
+	}
+	return isUpperLetter(c)
+}
+
+// matchCAPostalCode matches A9A[ -]9A9. strict is false when the code is not
+// uppercase or has no separator.
+func matchCAPostalCode(input string, i int) (end int, strict, ok bool) {
+	strict = true
+	j := i
+	for part := 0; part < 6; part++ {
+		if part == 3 {
+			if input[j] == ' ' || input[j] == '-' {
+				j++
+			} else {
+				strict = false
+			}
+		}
+		if j >= len(input) {
+			return 0, false, false
+		}
+		c := input[j]
+		if part%2 == 1 {
+			if !isDigitChar(c) {
+				return 0, false, false
+			}
+		} else {
+			if c >= 'a' && c <= 'z' {
+				strict = false
+			}
+			if !isCAPostalLetter(upperASCII(c), part == 0) {
+				return 0, false, false
+			}
+		}
+		j++
+	}
+	if j < len(input) && isAlnumChar(input[j]) {
+		return 0, false, false
+	}
+	return j, strict, true
+}
@@ -0,0 +1,52 @@
+package detectors
+
+import "testing"
+
+func TestCAPostalCodeDetector(t *testing.T) {
+	d := NewCAPostalCodeDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Spaced", "Ottawa, ON K1A 0B1", 1},
+		{"Hyphen", "Toronto M5V-3L9", 1},
+		{"Unspaced With Keyword", "Postal code: K1A0B1", 1},
+		{"Lowercase With Keyword", "code postal h2x 1y4", 1},
+
+		// Context
+		{"Unspaced Without Keyword", "ref K1A0B1", 0},
+
+		// Invalid Cases
+		{"Forbidden Letter", "K1D 0B1", 0},
+		{"W First", "W1A 0B1", 0},
+		{"Wrong Shape", "K1A 0BB", 0},
+
+		// Noise & Boundary
+		{"Sentence End", "Send to V6B 4Y8.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzCAPostalCode -fuzztime=10s
+func FuzzCAPostalCodeDetector(f *testing.F) {
+	d := NewCAPostalCodeDetector()
+
+	f.Add("K1A 0B1")
+	f.Add("K1A-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
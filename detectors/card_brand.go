@@ -0,0 +1,97 @@
+package detectors
+
+// Card brands reported in Match.Metadata["brand"].
+const (
+	CardBrandVisa       = "visa"
+	CardBrandMastercard = "mastercard"
+	CardBrandAmex       = "amex"
+	CardBrandElo        = "elo"
+	CardBrandHipercard  = "hipercard"
+	CardBrandDiscover   = "discover"
+	CardBrandJCB        = "jcb"
+	CardBrandUnionPay   = "unionpay"
+	CardBrandDiners     = "diners"
+)
+
+// cardBrandMetadata holds one shared, read-only metadata map per brand so
+// tagging a match does not allocate in ScanAppend; Scan hands out copies.
+var cardBrandMetadata = map[string]map[string]string{}
+
+func init() {
+	for _, b := range []string{
+		CardBrandVisa, CardBrandMastercard, CardBrandAmex, CardBrandElo,
+		CardBrandHipercard, CardBrandDiscover, CardBrandJCB, CardBrandUnionPay,
+		CardBrandDiners,
+	} {
+		cardBrandMetadata[b] = map[string]string{"brand": b}
+	}
+}
+
+// eloRanges lists the six-digit BIN ranges assigned to Elo. Several of them
+// sit inside Visa (4), Discover (65) and UnionPay (62/63) space, so Elo is
+// checked first.
+var eloRanges = [][2]int{
+	{401178, 401179}, {431274, 431274}, {438935, 438935}, {451416, 451416},
+	{457393, 457393}, {457631, 457632}, {504175, 504175}, {506699, 506778},
+	{509000, 509999}, {627780, 627780}, {636297, 636297}, {636368, 636368},
+	{650031, 650033}, {650035, 650051}, {650405, 650439}, {650485, 650538},
+	{650541, 650598}, {650700, 650718}, {650720, 650727}, {650901, 650978},
+	{651652, 651679}, {655000, 655019}, {655021, 655058},
+}
+
+// cardBrand identifies the issuing network from the IIN (first digits) and
+// returns "" when the prefix is unknown or the length is not one the brand
+// issues.
+func cardBrand(digits []byte) string {
+	n := len(digits)
+	p1 := int(digits[0] - '0')
+	p2 := p1*10 + int(digits[1]-'0')
+	p3 := p2*10 + int(digits[2]-'0')
+	p4 := p3*10 + int(digits[3]-'0')
+	p6 := p4*100 + int(digits[4]-'0')*10 + int(digits[5]-'0')
+
+	for _, r := range eloRanges {
+		if p6 >= r[0] && p6 <= r[1] {
+			if n == 16 {
+				return CardBrandElo
+			}
+			return ""
+		}
+	}
+
+	switch {
+	case p6 == 606282 || p6 == 384100 || p6 == 384140 || p6 == 384160:
+		if n == 16 || n == 19 {
+			return CardBrandHipercard
+		}
+	case p1 == 4:
+		if n == 13 || n == 16 || n == 19 {
+			return CardBrandVisa
+		}
+	case (p2 >= 51 && p2 <= 55) || (p4 >= 2221 && p4 <= 2720):
+		if n == 16 {
+			return CardBrandMastercard
+		}
+	case p2 == 34 || p2 == 37:
+		if n == 15 {
+			return CardBrandAmex
+		}
+	case p4 == 6011 || (p3 >= 644 && p3 <= 649) || p2 == 65 || (p6 >= 622126 && p6 <= 622925):
+		if n >= 16 && n <= 19 {
+			return CardBrandDiscover
+		}
+	case p4 >= 3528 && p4 <= 3589:
+		if n >= 16 && n <= 19 {
+			return CardBrandJCB
+		}
+	case p2 == 62:
+		if n >= 16 && n <= 19 {
+			return CardBrandUnionPay
+		}
+	case p2 == 36 || p2 == 38 || p2 == 39 || (p3 >= 300 && p3 <= 305) || p3 == 309:
+		if n >= 14 && n <= 19 {
+			return CardBrandDiners
+		}
+	}
+	return ""
+}
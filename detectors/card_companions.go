@@ -0,0 +1,164 @@
+package detectors
+
+// companionWindow is how far, in bytes, an expiry date or CVV may sit from a
+// card number and still be reported as belonging to it.
+const companionWindow = 64
+
+// cvvKeywords introduce a card security code. Matching is ASCII
+// case-insensitive; the longer spellings come first so "cvv2" wins over "cvv".
+var cvvKeywords = []string{
+	"security code", "código de segurança", "codigo de seguranca",
+	"cvv2", "cvc2", "cvv", "cvc", "cid", "csc",
+}
+
+// appendCardCompanions looks for expiry dates and keyword-introduced CVVs
+// near the card matches and appends them to cards.
+func appendCardCompanions(cards []Match, input string) []Match {
+	n := len(cards)
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+
+		switch {
+		case isDigitChar(c):
+			if i > 0 && (isDigitChar(input[i-1]) || input[i-1] == '/' || input[i-1] == '-') {
+				continue
+			}
+			end, ok := matchCardExpiry(input, i)
+			if !ok || !nearCard(cards[:n], i, end) {
+				continue
+			}
+			cards = append(cards, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeCardExpiry,
+				Score:      0.9,
+			})
+			i = end - 1
+
+		case isLetter(c):
+			if i > 0 && isLetter(input[i-1]) {
+				continue
+			}
+			start, end, ok := matchCVV(input, i)
+			if !ok || !nearCard(cards[:n], start, end) {
+				continue
+			}
+			cards = append(cards, Match{
+				StartIndex: start,
+				EndIndex:   end,
+				Value:      input[start:end],
+				Type:       TypeCVV,
+				Score:      0.9,
+			})
+			i = end - 1
+		}
+	}
+	return cards
+}
+
+// nearCard reports whether [start, end) lies within companionWindow bytes of
+// a card without overlapping it.
+func nearCard(cards []Match, start, end int) bool {
+	for _, m := range cards {
+		if start < m.EndIndex && end > m.StartIndex {
+			return false
+		}
+		if start < m.EndIndex+companionWindow && end > m.StartIndex-companionWindow {
+			return true
+		}
+	}
+	return false
+}
+
+// matchCardExpiry reads MM/YY, MM/YYYY, MM-YY or MM-YYYY starting at i.
+func matchCardExpiry(s string, i int) (int, bool) {
+	n := len(s)
+	if i+5 > n || !isDigitChar(s[i+1]) || (s[i+2] != '/' && s[i+2] != '-') {
+		return 0, false
+	}
+	month := int(s[i]-'0')*10 + int(s[i+1]-'0')
+	if month < 1 || month > 12 {
+		return 0, false
+	}
+
+	j := i + 3
+	digits := 0
+	for j < n && isDigitChar(s[j]) && digits < 5 {
+		j++
+		digits++
+	}
+	switch digits {
+	case 2:
+	case 4:
+		if s[i+3] != '2' || s[i+4] != '0' {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	// Reject full dates such as 12/05/2027
+	if j < n && (s[j] == '/' || s[j] == '-') {
+		return 0, false
+	}
+	return j, true
+}
+
+// matchCVV matches a CVV keyword at i followed by a 3 or 4 digit code and
+// returns the span of the code only.
+func matchCVV(s string, i int) (int, int, bool) {
+	for _, kw := range cvvKeywords {
+		if !hasPrefixFold(s[i:], kw) {
+			continue
+		}
+		j := i + len(kw)
+		if j < len(s) && isLetter(s[j]) {
+			continue
+		}
+
+		// Skip punctuation such as "CVV: ", "CVV2 = ", "cvc (123)"
+		for k := 0; k < 4 && j < len(s) && isCVVFiller(s[j]); k++ {
+			j++
+		}
+		start := j
+		for j < len(s) && isDigitChar(s[j]) {
+			j++
+		}
+		if l := j - start; l < 3 || l > 4 {
+			return 0, 0, false
+		}
+		if j < len(s) && isAlnumChar(s[j]) {
+			return 0, 0, false
+		}
+		return start, j, true
+	}
+	return 0, 0, false
+}
+
+func isCVVFiller(b byte) bool {
+	switch b {
+	case ' ', ':', '=', '#', '-', '.', '(':
+		return true
+	default:
+		return false
+	}
+}
+
+// hasPrefixFold reports whether s starts with prefix, ignoring ASCII case.
+// prefix must be lowercase.
+func hasPrefixFold(s, prefix string) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for k := 0; k < len(prefix); k++ {
+		c := s[k]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if c != prefix[k] {
+			return false
+		}
+	}
+	return true
+}
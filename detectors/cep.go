@@ -0,0 +1,98 @@
+package detectors
+
+// CEPDetector finds Brazilian postal codes (CEP). The hyphenated 01310-100 and
+// 01.310-100 forms are accepted on their own; a bare 01310100 needs the "CEP"
+// keyword in front, as do codes that directly follow other digit groups (phone
+// numbers such as "11 98765-432").
+type CEPDetector struct{}
+
+func (d *CEPDetector) Name() string {
+	return "br_cep"
+}
+
+var cepKeywords = []string{"cep"}
+
+// postalKeywordWindow is how far before a postal code its keyword may appear.
+const postalKeywordWindow = 24
+
+func (d *CEPDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *CEPDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *CEPDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [8]byte
+
+	for i := 0; i+8 <= len(input); i++ {
+		if !isDigitChar(input[i]) || (i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '-' || input[i-1] == '.')) {
+			continue
+		}
+
+		end, hyphen, ok := readCEP(input, i, &digits)
+		if !ok || string(digits[:]) == "00000000" {
+			continue
+		}
+
+		keyword := keywordBefore(input, i, postalKeywordWindow, cepKeywords) != ""
+		afterDigits := i > 1 && isDigitChar(input[i-2])
+		if !keyword && (!hyphen || afterDigits) {
+			continue
+		}
+
+		score := float32(0.8)
+		if keyword {
+			score = 1.0
+		}
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeCEP,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewCEPDetector() Detector {
+	return &CEPDetector{}
+}
+
+// readCEP reads NNNNN-NNN, NN.NNN-NNN or NNNNNNNN at i.
+func readCEP(input string, i int, digits *[8]byte) (end int, hyphen, ok bool) {
+	count := 0
+	j := i
+	for j < len(input) && count < 8 {
+		c := input[j]
+		switch {
+		case isDigitChar(c):
+			digits[count] = c
+			count++
+		case c == '.' && count == 2 && j+1 < len(input) && isDigitChar(input[j+1]):
+		case c == '-' && count == 5 && j+1 < len(input) && isDigitChar(input[j+1]):
+			hyphen = true
+		default:
+			return 0, false, false
+		}
+		j++
+	}
+	if count < 8 {
+		return 0, false, false
+	}
+	// A dotted CEP must also use the hyphen
+	if input[i+2] == '.' && !hyphen {
+		return 0, false, false
+	}
+	if j < len(input) && (isAlnumChar(input[j]) ||
+		((input[j] == '-' || input[j] == '.') && j+1 < len(input) && isDigitChar(input[j+1]))) {
+		return 0, false, false
+	}
+	return j, hyphen, true
+}
@@ -0,0 +1,56 @@
+package detectors
+
+import "testing"
+
+func TestCEPDetector(t *testing.T) {
+	d := NewCEPDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Hyphenated", "Av. Paulista, 1578 - 01310-200 São Paulo", 1},
+		{"Dotted", "Entrega em 01.310-200", 1},
+		{"Bare With Keyword", "CEP 01310200", 1},
+		{"Keyword With Colon", "cep: 70040-010", 1},
+
+		// Context
+		{"Bare Without Keyword", "Pedido 01310200 enviado", 0},
+		{"After Phone Digits", "Tel 11 98765-432", 0},
+		{"After Digits With Keyword", "CEP 2 01310-200", 1},
+
+		// Invalid Cases
+		{"All Zeros", "CEP 00000-000", 0},
+		{"Phone Shape", "Ligue 98765-4321", 0},
+		{"CPF Tail", "CPF 123.456.789-09", 0},
+		{"Dotted Without Hyphen", "CEP 01.310200", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "CEP 🚀 01310-200", 1},
+		{"Sentence End", "Moro no CEP 01310-200.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzCEP -fuzztime=10s
+func FuzzCEPDetector(f *testing.F) {
+	d := NewCEPDetector()
+
+	f.Add("CEP 01310-200")
+	f.Add("01.310-200")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -7,62 +7,69 @@ func (d *CNPJDetector) Name() string {
 }
 
 func (d *CNPJDetector) Scan(input string) []Match {
-	var results []Match
-	var buffer [14]byte
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *CNPJDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
 
+func (d *CNPJDetector) scan(dst []Match, input string) []Match {
+	results := dst
 	for i := 0; i < len(input); i++ {
 		if !isDigitChar(input[i]) {
 			continue
 		}
-		if i > 0 && isDigitChar(input[i-1]) {
-			continue
+		if end, ok := matchCNPJAt(input, i); ok {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeCNPJ,
+				Score:      1.0,
+			})
+			i = end - 1
 		}
+	}
+	return results
+}
 
-		start := i
-		count := 0
-		j := i
-
-		for j < len(input) && count < 14 {
-			c := input[j]
-			switch {
-			case isDigitChar(c):
-				buffer[count] = c
-				count++
-			case isCNPJSeparator(c):
-				if !validCNPJSeparatorPosition(c, count) {
-					goto nextCandidate
-				}
-			case c == ' ':
-				if count == 0 {
-					goto nextCandidate
-				}
-			default:
-				goto evaluate
-			}
-			j++
-		}
+// matchCNPJAt returns the end of a valid CNPJ starting at the digit input[i].
+func matchCNPJAt(input string, i int) (int, bool) {
+	if i > 0 && isDigitChar(input[i-1]) {
+		return 0, false
+	}
 
-	evaluate:
-		if count == 14 {
-			if j < len(input) && isDigitChar(input[j]) {
-				goto nextCandidate
+	var buffer [14]byte
+	count := 0
+	j := i
+
+	for j < len(input) && count < 14 {
+		c := input[j]
+		switch {
+		case isDigitChar(c):
+			buffer[count] = c
+			count++
+		case isCNPJSeparator(c):
+			if !validCNPJSeparatorPosition(c, count) {
+				return 0, false
 			}
-			if isValidCNPJBytes(buffer[:]) {
-				results = append(results, Match{
-					StartIndex: start,
-					EndIndex:   j,
-					Value:      input[start:j],
-					Type:       TypeCNPJ,
-					Score:      1.0,
-				})
-				i = j - 1
-				continue
+		case c == ' ':
+			if count == 0 {
+				return 0, false
 			}
+		default:
+			goto evaluate
 		}
-	nextCandidate:
+		j++
 	}
 
-	return results
+evaluate:
+	if count != 14 || (j < len(input) && isDigitChar(input[j])) {
+		return 0, false
+	}
+	return j, isValidCNPJBytes(buffer[:])
 }
 
 func NewCNPJDetector() Detector {
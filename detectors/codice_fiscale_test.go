@@ -0,0 +1,49 @@
+package detectors
+
+import "testing"
+
+func TestCodiceFiscaleDetector(t *testing.T) {
+	d := NewCodiceFiscaleDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid", "Codice fiscale RSSMRA85T10A562S.", 1},
+		{"Valid Omocodia", "CF RSSMRA85T10A56NH", 1},
+
+		// Near Misses
+		{"Wrong Check Letter", "CF RSSMRA85T10A562T", 0},
+		{"Invalid Month Letter", "CF RSSMRA85Z10A562S", 0},
+		{"Invalid Day", "CF RSSMRA85T35A562S", 0},
+		{"Lowercase", "cf rssmra85t10a562s", 0},
+		{"Too Long", "CF RSSMRA85T10A562SX", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "CF RSSMRA85T10A562S 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzCodiceFiscale -fuzztime=10s
+func FuzzCodiceFiscaleDetector(f *testing.F) {
+	d := NewCodiceFiscaleDetector()
+
+	f.Add("RSSMRA85T10A562S")
+	f.Add("RSSMRA85T10A56NH")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
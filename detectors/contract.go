@@ -7,6 +7,16 @@ type Match struct {
 	Value      string
 	Type       PIIType // e.g. TypeCPF, TypeEmail
 	Score      float32 // Confidence score (0.0 to 1.0)
+
+	// Metadata carries detector-specific details (e.g. "brand" for cards).
+	// Matches returned by Scan own it. Matches appended by ScanAppend may
+	// share it with other matches and later calls, so it must be treated
+	// as read-only there.
+	Metadata map[string]string
+
+	// Replacement, when set, is written in place of the value instead of a
+	// token (e.g. coarsened coordinates). Such matches are not restorable.
+	Replacement string
 }
 
 // PIIType enumerates all built-in detector kinds.
@@ -15,12 +25,73 @@ type PIIType string
 const (
 	TypeEmail      PIIType = "EMAIL"
 	TypeCreditCard PIIType = "CREDIT_CARD"
+	TypeCardExpiry PIIType = "CARD_EXPIRY"
+	TypeCVV        PIIType = "CVV"
 	TypeIP         PIIType = "IP"
 	TypeUUID       PIIType = "UUID"
+	TypeMAC        PIIType = "MAC_ADDRESS"
+	TypeIMEI       PIIType = "IMEI"
+	TypeDeviceID   PIIType = "DEVICE_ID"
 	TypePhone      PIIType = "PHONE"
 	TypeCPF        PIIType = "CPF"
 	TypeCNPJ       PIIType = "CNPJ"
-	TypeCustom     PIIType = "CUSTOM"
+	TypeCUIT       PIIType = "CUIT"
+	TypeRUT        PIIType = "RUT"
+	TypeRFC        PIIType = "RFC"
+	TypeCURP       PIIType = "CURP"
+	TypeNIT        PIIType = "NIT"
+	TypeRUC        PIIType = "RUC"
+
+	TypeNINO          PIIType = "NINO"
+	TypeNHS           PIIType = "NHS"
+	TypeDNI           PIIType = "DNI"
+	TypeNIE           PIIType = "NIE"
+	TypeCodiceFiscale PIIType = "CODICE_FISCALE"
+	TypeNIR           PIIType = "NIR"
+	TypeSteuerID      PIIType = "STEUER_ID"
+	TypeBSN           PIIType = "BSN"
+	TypeNIF           PIIType = "NIF"
+
+	TypeAadhaar  PIIType = "AADHAAR"
+	TypePAN      PIIType = "PAN"
+	TypeNRIC     PIIType = "NRIC"
+	TypeTFN      PIIType = "TFN"
+	TypeMedicare PIIType = "MEDICARE"
+
+	TypeBitcoinAddress  PIIType = "BTC_ADDRESS"
+	TypeEthereumAddress PIIType = "ETH_ADDRESS"
+	TypeSolanaAddress   PIIType = "SOL_ADDRESS"
+	TypeTronAddress     PIIType = "TRX_ADDRESS"
+
+	TypeURLCredential PIIType = "URL_CREDENTIAL"
+	TypeURLParam      PIIType = "URL_PARAM"
+
+	TypeDBPassword PIIType = "DB_PASSWORD"
+	TypeDBUser     PIIType = "DB_USER"
+	TypeDBHost     PIIType = "DB_HOST"
+
+	TypeDateOfBirth PIIType = "DATE_OF_BIRTH"
+	TypeDate        PIIType = "DATE"
+
+	TypeCEP           PIIType = "CEP"
+	TypeZIPCode       PIIType = "ZIP_CODE"
+	TypeUKPostcode    PIIType = "UK_POSTCODE"
+	TypeCAPostalCode  PIIType = "CA_POSTAL_CODE"
+	TypeStreetAddress PIIType = "STREET_ADDRESS"
+
+	TypeName        PIIType = "NAME"
+	TypeCoordinates PIIType = "COORDINATES"
+
+	TypeLicensePlate PIIType = "LICENSE_PLATE"
+	TypeVIN          PIIType = "VIN"
+	TypeRENAVAM      PIIType = "RENAVAM"
+
+	TypeNPI   PIIType = "NPI"
+	TypeDEA   PIIType = "DEA"
+	TypeMRN   PIIType = "MRN"
+	TypeICD10 PIIType = "ICD10"
+
+	TypeCustom PIIType = "CUSTOM"
 )
 
 // Detector is the interface that every PII identifier must implement.
@@ -31,3 +102,15 @@ type Detector interface {
 	// Scan scans the input and returns all valid occurrences
 	Scan(input string) []Match
 }
+
+// AppendScanner is implemented by detectors that can scan without
+// allocating: matches are appended to dst and their Value shares memory
+// with input, so it is only valid while input is left unmodified. Their
+// Metadata maps are shared and read-only. All built-in detectors except
+// the URL, DSN, pattern and dictionary ones implement it.
+type AppendScanner interface {
+	Detector
+
+	// ScanAppend appends the occurrences found in input to dst
+	ScanAppend(dst []Match, input []byte) []Match
+}
@@ -0,0 +1,73 @@
+package detectors
+
+import (
+	"reflect"
+	"testing"
+)
+
+const appendScanSample = `Contact me at john.doe@example.com, CPF 111.444.777-35, CNPJ 00.000.000/0001-91.
+Charging card 4111 1111 1111 1111 exp 12/27 cvv 123 from 192.168.0.1, call +55 11 99999-9999.
+TraceID 123e4567-e89b-12d3-a456-426614174000, CUIT 20-12345678-6, RUT 10.000.013-K.
+RFC GODE561231GR8, CURP GOMC850812HDFNRR04, NIT 900.123.456-8, RUC 10461234564.
+Patient NINO AB 12 34 56 C, NHS number 943 476 5919, DNI 12345678Z, NIE X1234567L.
+CF RSSMRA85T10A562S / NIR 1 85 05 78 006 084 91, Steuer-ID 86 095 742 719, BSN 1234.56.782.
+Aadhaar 2345 6789 0124, PAN ABCPE1234F, NRIC S1234567D, TFN 123 456 782, Medicare 2123 45670 1.
+wifi=00:1A:2B:3C:4D:5E imei=35-209900-176148-1 idfa=6D92078A-8246-4BA4-AE5B-76104861E7DC
+btc 1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2 eth 0x52908400098527886E0F7030069857D2E4169EE7
+Mr. John Smith, born 03/12/1985, lives at 123 Main Street, ZIP 94105, at -23.5505, -46.6333.
+Plate ABC1D23, VIN 1HGCM82633A004352, CEP 01310-100, SW1A 1AA, K1A 0B1.`
+
+func TestAppendScanner_MatchesScan(t *testing.T) {
+	builtins := []Detector{
+		NewAadhaarDetector(), NewBSNDetector(), NewBitcoinDetector(), NewCAPostalCodeDetector(),
+		NewCEPDetector(), NewCNPJDetector(), NewCPFDetector(), NewCUITDetector(), NewCURPDetector(),
+		NewCodiceFiscaleDetector(), NewCoordinatesDetector(), NewCreditCardDetector(),
+		&CreditCardDetector{Companions: true}, NewDEADetector(), NewDNIDetector(), NewDateDetector(),
+		NewDeviceIDDetector(), NewEmailDetector(), NewEthereumDetector(), NewICD10Detector(),
+		NewIMEIDetector(), NewIPDetector(), NewLicensePlateDetector(), NewMACDetector(),
+		NewMRNDetector(), NewMedicareDetector(), NewNHSDetector(), NewNIEDetector(), NewNIFDetector(),
+		NewNINODetector(), NewNIRDetector(), NewNITDetector(), NewNPIDetector(), NewNRICDetector(),
+		NewNameDetector(), NewPANDetector(), NewPhoneDetector(), NewRENAVAMDetector(), NewRFCDetector(),
+		NewRUCDetector(), NewRUTDetector(), NewSolanaDetector(), NewSteuerIDDetector(),
+		NewStreetAddressDetector(), NewTFNDetector(), NewTronDetector(), NewUKPostcodeDetector(),
+		NewUUIDDetector(), NewVINDetector(), NewZIPDetector(),
+	}
+
+	prefix := Match{StartIndex: -1, EndIndex: -1, Value: "prefix", Type: TypeCustom}
+	for _, d := range builtins {
+		as, ok := d.(AppendScanner)
+		if !ok {
+			t.Errorf("%s does not implement AppendScanner", d.Name())
+			continue
+		}
+		want := d.Scan(appendScanSample)
+
+		got := as.ScanAppend([]Match{prefix}, []byte(appendScanSample))
+		if len(got) == 0 || !reflect.DeepEqual(got[0], prefix) {
+			t.Errorf("%s: ScanAppend overwrote dst", d.Name())
+			continue
+		}
+		if len(want) == 0 && len(got) == 1 {
+			continue
+		}
+		if !reflect.DeepEqual(got[1:], want) {
+			t.Errorf("%s: ScanAppend = %v, Scan = %v", d.Name(), got[1:], want)
+		}
+	}
+}
+
+func BenchmarkCPFDetector_ScanAppend(b *testing.B) {
+	d := NewCPFDetector().(AppendScanner)
+	payload := []byte(`
+Report Data:
+Client A CPF 111.444.777-35
+Client B CPF 529.982.247-25
+Client C CPF 862.883.667-57
+`)
+	dst := make([]Match, 0, 8)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = d.ScanAppend(dst[:0], payload)
+	}
+}
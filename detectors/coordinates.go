@@ -0,0 +1,366 @@
+package detectors
+
+import "strconv"
+
+// CoordinatesDetector finds GPS coordinates:
+//
+//   - decimal pairs: "-23.5505, -46.6333"
+//   - DMS pairs: 23°33'01"S 46°38'02"W
+//   - geo URIs: geo:-23.5505,-46.6333
+//   - keyed values: lat=-23.5505&lng=-46.6333, "latitude": -23.5505
+//
+// Latitudes must lie in [-90, 90] and longitudes in [-180, 180]. The score
+// follows the precision: 5+ decimals (~1 m) score 1.0, 4 decimals (~10 m)
+// 0.9, 3 decimals (~100 m) 0.7. Bare decimal pairs need 4 decimals, or 3
+// with a sign or a location keyword before them, so that thousands such as
+// "1.500, 2.300" are left alone; keyed values and geo URIs accept coarser
+// ones with a lower score.
+type CoordinatesDetector struct {
+	// Coarsen sets Match.Replacement to the coordinates rounded to 2
+	// decimals (~1 km) so the area stays visible but the address does not.
+	Coarsen bool
+}
+
+// coordinateDecimals is the precision kept by Coarsen.
+const coordinateDecimals = 2
+
+var (
+	latKeys = []string{"latitude", "lat"}
+	lonKeys = []string{"longitude", "lng", "lon"} // not "long": "how long: 2.5 hours"
+
+	coordinateKeywords = []string{
+		"coordinates", "coords", "gps", "location", "position", "pos", "pin",
+		"coordenadas", "localização", "localizacao", "posição", "posicao", "ubicación", "ubicacion",
+	}
+)
+
+// coordinateWindow is how far before a bare pair its label may appear.
+const coordinateWindow = 24
+
+func (d *CoordinatesDetector) Name() string {
+	return "coordinates"
+}
+
+func (d *CoordinatesDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *CoordinatesDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *CoordinatesDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '.' || input[i-1] >= 0x80) {
+			continue
+		}
+
+		switch {
+		case c == 'g' || c == 'G':
+			if !hasPrefixFold(input[i:], "geo:") {
+				continue
+			}
+			if end, m, ok := d.matchDecimalPair(input, i+4, 0); ok {
+				results = append(results, m)
+				i = end - 1
+			}
+		case isLetter(c):
+			if end, m, ok := d.matchKeyedCoordinate(input, i); ok {
+				results = append(results, m)
+				i = end - 1
+			}
+		case isDigitChar(c) || c == '-' || c == '+':
+			if end, m, ok := d.matchDMSPair(input, i); ok {
+				results = append(results, m)
+				i = end - 1
+			} else if end, m, ok := d.matchDecimalPair(input, i, 3); ok && isLikelyBarePair(input, i, m) {
+				results = append(results, m)
+				i = end - 1
+			}
+		}
+	}
+
+	return results
+}
+
+func NewCoordinatesDetector() Detector {
+	return &CoordinatesDetector{}
+}
+
+// coordinateScore maps decimal places to a confidence score.
+func coordinateScore(decimals int) float32 {
+	switch {
+	case decimals >= 5:
+		return 1.0
+	case decimals == 4:
+		return 0.9
+	case decimals == 3:
+		return 0.7
+	case decimals >= 1:
+		return 0.5
+	}
+	return 0.3
+}
+
+// isLikelyBarePair reports whether the bare pair m found at i is precise
+// enough, signed or labelled to be read as coordinates rather than numbers.
+func isLikelyBarePair(input string, i int, m Match) bool {
+	if m.Score >= coordinateScore(4) {
+		return true
+	}
+	for k := 0; k < len(m.Value); k++ {
+		if m.Value[k] == '-' || m.Value[k] == '+' {
+			return true
+		}
+	}
+	return keywordBefore(input, i, coordinateWindow, coordinateKeywords) != ""
+}
+
+// readCoordinate reads [+-]D[DD][.D...] at i.
+func readCoordinate(input string, i int) (value float64, decimals, end int, ok bool) {
+	j := i
+	neg := false
+	if j < len(input) && (input[j] == '-' || input[j] == '+') {
+		neg = input[j] == '-'
+		j++
+	}
+	start := j
+	for j < len(input) && j-start < 3 && isDigitChar(input[j]) {
+		value = value*10 + float64(input[j]-'0')
+		j++
+	}
+	if j == start || (j < len(input) && isDigitChar(input[j])) {
+		return 0, 0, 0, false
+	}
+	if j+1 < len(input) && input[j] == '.' && isDigitChar(input[j+1]) {
+		j++
+		scale := 0.1
+		for j < len(input) && isDigitChar(input[j]) {
+			value += float64(input[j]-'0') * scale
+			scale /= 10
+			decimals++
+			j++
+		}
+	}
+	if j < len(input) && (isAlnumChar(input[j]) || (input[j] == '.' && j+1 < len(input) && isDigitChar(input[j+1]))) {
+		return 0, 0, 0, false
+	}
+	if neg {
+		value = -value
+	}
+	return value, decimals, j, true
+}
+
+// matchDecimalPair matches "lat, lon" at i; both need minDecimals places.
+func (d *CoordinatesDetector) matchDecimalPair(input string, i, minDecimals int) (int, Match, bool) {
+	lat, latDec, j, ok := readCoordinate(input, i)
+	if !ok || lat < -90 || lat > 90 || latDec < minDecimals {
+		return 0, Match{}, false
+	}
+	if j >= len(input) || input[j] != ',' {
+		return 0, Match{}, false
+	}
+	k := skipDateSpaces(input, j+1)
+	lon, lonDec, end, ok := readCoordinate(input, k)
+	if !ok || lon < -180 || lon > 180 || lonDec < minDecimals {
+		return 0, Match{}, false
+	}
+
+	m := Match{
+		StartIndex: i,
+		EndIndex:   end,
+		Value:      input[i:end],
+		Type:       TypeCoordinates,
+		Score:      coordinateScore(minInt(latDec, lonDec)),
+	}
+	if d.Coarsen {
+		m.Replacement = coarsenCoordinate(input[i:j], latDec) + input[j:k] + coarsenCoordinate(input[k:end], lonDec)
+	}
+	return end, m, true
+}
+
+// matchKeyedCoordinate matches a value introduced by lat/lng style keys.
+func (d *CoordinatesDetector) matchKeyedCoordinate(input string, i int) (int, Match, bool) {
+	limit := 90.0
+	j := matchCoordinateKey(input, i, latKeys)
+	if j < 0 {
+		limit = 180
+		if j = matchCoordinateKey(input, i, lonKeys); j < 0 {
+			return 0, Match{}, false
+		}
+	}
+
+	value, decimals, end, ok := readCoordinate(input, j)
+	if !ok || decimals == 0 || value < -limit || value > limit {
+		return 0, Match{}, false
+	}
+
+	m := Match{
+		StartIndex: j,
+		EndIndex:   end,
+		Value:      input[j:end],
+		Type:       TypeCoordinates,
+		Score:      coordinateScore(decimals),
+	}
+	if d.Coarsen {
+		m.Replacement = coarsenCoordinate(input[j:end], decimals)
+	}
+	return end, m, true
+}
+
+// matchCoordinateKey matches key[" ][ ]{=|:}[ ]["] at i and returns where
+// the value starts, or -1.
+func matchCoordinateKey(input string, i int, keys []string) int {
+	for _, key := range keys {
+		if !hasPrefixFold(input[i:], key) {
+			continue
+		}
+		j := i + len(key)
+		if j < len(input) && input[j] == '"' {
+			j++
+		}
+		j = skipDateSpaces(input, j)
+		if j >= len(input) || (input[j] != '=' && input[j] != ':') {
+			continue
+		}
+		j = skipDateSpaces(input, j+1)
+		if j < len(input) && input[j] == '"' {
+			j++
+		}
+		return j
+	}
+	return -1
+}
+
+// coarsenCoordinate rounds a decimal coordinate to coordinateDecimals places.
+func coarsenCoordinate(s string, decimals int) string {
+	if decimals <= coordinateDecimals {
+		return s
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return s
+	}
+	return strconv.FormatFloat(v, 'f', coordinateDecimals, 64)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// matchDMSPair matches two degree-minute-second coordinates, one with an N/S
+// hemisphere and one with E/W, e.g. 23°33'01"S 46°38'02"W.
+func (d *CoordinatesDetector) matchDMSPair(input string, i int) (int, Match, bool) {
+	first, firstHemi, j, ok := readDMS(input, i)
+	if !ok {
+		return 0, Match{}, false
+	}
+	k := j
+	for k < len(input) && (input[k] == ' ' || input[k] == ',') {
+		k++
+	}
+	second, secondHemi, end, ok := readDMS(input, k)
+	if !ok {
+		return 0, Match{}, false
+	}
+
+	lat, lon := first, second
+	switch {
+	case isLatHemisphere(firstHemi) && !isLatHemisphere(secondHemi):
+	case !isLatHemisphere(firstHemi) && isLatHemisphere(secondHemi):
+		lat, lon = second, first
+	default:
+		return 0, Match{}, false
+	}
+	if lat > 90 || lon > 180 {
+		return 0, Match{}, false
+	}
+
+	m := Match{
+		StartIndex: i,
+		EndIndex:   end,
+		Value:      input[i:end],
+		Type:       TypeCoordinates,
+		Score:      0.9,
+	}
+	if d.Coarsen {
+		if firstHemi == 'S' || firstHemi == 'W' {
+			first = -first
+		}
+		if secondHemi == 'S' || secondHemi == 'W' {
+			second = -second
+		}
+		m.Replacement = strconv.FormatFloat(first, 'f', coordinateDecimals, 64) + ", " +
+			strconv.FormatFloat(second, 'f', coordinateDecimals, 64)
+	}
+	return end, m, true
+}
+
+func isLatHemisphere(h byte) bool {
+	return h == 'N' || h == 'S'
+}
+
+// readDMSMark skips one of marks at j, and returns -1 if none is there.
+func readDMSMark(input string, j int, marks ...string) int {
+	for _, mark := range marks {
+		if len(input)-j >= len(mark) && input[j:j+len(mark)] == mark {
+			return j + len(mark)
+		}
+	}
+	return -1
+}
+
+// readDMS reads D°M'[S"]H and returns the absolute value in degrees and the
+// hemisphere letter.
+func readDMS(input string, i int) (float64, byte, int, bool) {
+	deg, j := readDateNumber(input, i, 3)
+	if j == i {
+		return 0, 0, 0, false
+	}
+	if j = readDMSMark(input, j, "°", "º"); j < 0 {
+		return 0, 0, 0, false
+	}
+	j = skipDateSpaces(input, j)
+
+	minutes, k := readDateNumber(input, j, 2)
+	if k == j || minutes >= 60 {
+		return 0, 0, 0, false
+	}
+	if j = readDMSMark(input, k, "'", "′", "’"); j < 0 {
+		return 0, 0, 0, false
+	}
+	j = skipDateSpaces(input, j)
+
+	var sec float64
+	if j < len(input) && isDigitChar(input[j]) {
+		value, _, k, ok := readCoordinate(input, j)
+		if !ok || value >= 60 {
+			return 0, 0, 0, false
+		}
+		if j = readDMSMark(input, k, "\"", "″", "''", "”"); j < 0 {
+			return 0, 0, 0, false
+		}
+		sec = value
+		j = skipDateSpaces(input, j)
+	}
+
+	if j >= len(input) {
+		return 0, 0, 0, false
+	}
+	hemi := input[j]
+	if hemi != 'N' && hemi != 'S' && hemi != 'E' && hemi != 'W' {
+		return 0, 0, 0, false
+	}
+	j++
+	if j < len(input) && isAlnumChar(input[j]) {
+		return 0, 0, 0, false
+	}
+	return float64(deg) + float64(minutes)/60 + sec/3600, hemi, j, true
+}
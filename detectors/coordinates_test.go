@@ -0,0 +1,107 @@
+package detectors
+
+import "testing"
+
+func TestCoordinatesDetector(t *testing.T) {
+	d := NewCoordinatesDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Decimal pairs
+		{"Decimal Pair", "Delivered at -23.5505, -46.6333 today", []string{"-23.5505, -46.6333"}},
+		{"No Space", "pos=40.71278,-74.00594;", []string{"40.71278,-74.00594"}},
+		{"Three Decimals", "Pin 51.507, -0.128", []string{"51.507, -0.128"}},
+		{"Three Decimals Labelled", "Localização: 51.507, 0.128", []string{"51.507, 0.128"}},
+
+		// DMS
+		{"DMS", `Local: 23°33'01"S 46°38'02"W`, []string{`23°33'01"S 46°38'02"W`}},
+		{"DMS Unicode Marks", "40°42′46″N, 74°00′21″W", []string{"40°42′46″N, 74°00′21″W"}},
+		{"DMS Lon First", `46°38'W 23°33'S`, []string{`46°38'W 23°33'S`}},
+
+		// Geo URI & keys
+		{"Geo URI", "geo:37.786971,-122.399677;u=35", []string{"37.786971,-122.399677"}},
+		{"Query Keys", "map?lat=-23.5505&lng=-46.6333", []string{"-23.5505", "-46.6333"}},
+		{"JSON Keys", `{"latitude": -23.55, "longitude": -46.63}`, []string{"-23.55", "-46.63"}},
+
+		// Range & precision
+		{"Latitude Out Of Range", "Point 91.1234, 10.1234", nil},
+		{"Longitude Out Of Range", "Point 10.1234, 181.1234", nil},
+		{"Too Coarse", "Ratio 1.5, 2.5", nil},
+		{"Version List", "Versions 1.2.3, 4.5.6", nil},
+		{"Brazilian Money", "Total R$ 1.234,56", nil},
+		{"Brazilian Thousands", "vendemos 1.500, 2.300 e 4.100 unidades", nil},
+		{"Key Out Of Range", "lat=123.456", nil},
+		{"Not A Key", "latency: 12.5", nil},
+		{"DMS Bad Minutes", `23°75'S 46°38'W`, nil},
+		{"DMS Same Axis", `23°33'S 46°38'N`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if len(matches) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %d matches, got %d: %+v", tt.input, len(tt.expected), len(matches), matches)
+			}
+			for k, m := range matches {
+				if m.Value != tt.expected[k] {
+					t.Errorf("match %d: expected %q, got %q", k, tt.expected[k], m.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestCoordinatesDetector_Score(t *testing.T) {
+	d := NewCoordinatesDetector()
+
+	precise := d.Scan("-23.550520, -46.633308")
+	coarse := d.Scan("-23.551, -46.633")
+	if len(precise) != 1 || len(coarse) != 1 {
+		t.Fatalf("expected one match each, got %d and %d", len(precise), len(coarse))
+	}
+	if precise[0].Score <= coarse[0].Score {
+		t.Errorf("precise score %v should exceed coarse score %v", precise[0].Score, coarse[0].Score)
+	}
+}
+
+func TestCoordinatesDetector_Coarsen(t *testing.T) {
+	d := &CoordinatesDetector{Coarsen: true}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"-23.5505, -46.6333", "-23.55, -46.63"},
+		{"lat=-23.5567", "-23.56"},
+		{`23°33'01"S 46°38'02"W`, "-23.55, -46.63"},
+		{"geo:37.786971,-122.399677", "37.79,-122.40"},
+	}
+
+	for _, tt := range tests {
+		matches := d.Scan(tt.input)
+		if len(matches) != 1 {
+			t.Fatalf("input %q: expected 1 match, got %d", tt.input, len(matches))
+		}
+		if matches[0].Replacement != tt.expected {
+			t.Errorf("input %q: expected replacement %q, got %q", tt.input, tt.expected, matches[0].Replacement)
+		}
+	}
+}
+
+// Run with: go test -fuzz=FuzzCoordinates -fuzztime=10s
+func FuzzCoordinatesDetector(f *testing.F) {
+	d := &CoordinatesDetector{Coarsen: true}
+
+	f.Add("-23.5505, -46.6333")
+	f.Add(`23°33'01"S 46°38'02"W`)
+	f.Add("geo:1,2")
+	f.Add("lat=")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -7,59 +7,65 @@ func (d *CPFDetector) Name() string {
 }
 
 func (d *CPFDetector) Scan(input string) []Match {
-	var results []Match
-	var digits [11]byte
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *CPFDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
 
+func (d *CPFDetector) scan(dst []Match, input string) []Match {
+	results := dst
 	for i := 0; i < len(input); i++ {
 		if !isDigitChar(input[i]) {
 			continue
 		}
-		if i > 0 && isDigitChar(input[i-1]) {
-			continue
+		if end, ok := matchCPFAt(input, i); ok {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeCPF,
+				Score:      1.0,
+			})
+			i = end - 1
 		}
+	}
+	return results
+}
 
-		start := i
-		count := 0
-		j := i
-
-		for j < len(input) && count < 11 {
-			c := input[j]
-			switch {
-			case isDigitChar(c):
-				digits[count] = c
-				count++
-			case isCPFSeparator(c):
-				if count == 0 || !validCPFSeparatorPosition(c, count) {
-					goto nextCandidate
-				}
-			default:
-				goto evaluate
-			}
-			j++
-		}
+// matchCPFAt returns the end of a valid CPF starting at the digit input[i].
+func matchCPFAt(input string, i int) (int, bool) {
+	if i > 0 && isDigitChar(input[i-1]) {
+		return 0, false
+	}
 
-	evaluate:
-		if count == 11 {
-			if j < len(input) && isDigitChar(input[j]) {
-				goto nextCandidate
-			}
-			if isValidCPFBytes(digits[:]) {
-				results = append(results, Match{
-					StartIndex: start,
-					EndIndex:   j,
-					Value:      input[start:j],
-					Type:       TypeCPF,
-					Score:      1.0,
-				})
-				i = j - 1
-				continue
+	var digits [11]byte
+	count := 0
+	j := i
+
+	for j < len(input) && count < 11 {
+		c := input[j]
+		switch {
+		case isDigitChar(c):
+			digits[count] = c
+			count++
+		case isCPFSeparator(c):
+			if count == 0 || !validCPFSeparatorPosition(c, count) {
+				return 0, false
 			}
+		default:
+			goto evaluate
 		}
-
-	nextCandidate:
+		j++
 	}
 
-	return results
+evaluate:
+	if count != 11 || (j < len(input) && isDigitChar(input[j])) {
+		return 0, false
+	}
+	return j, isValidCPFBytes(digits[:])
 }
 
 func NewCPFDetector() Detector {
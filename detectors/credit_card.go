@@ -1,61 +1,56 @@
 package detectors
 
-type CreditCardDetector struct{}
+// CreditCardDetector finds payment card numbers. A number must pass Luhn and
+// fall in a known brand's IIN range with a length that brand issues; the
+// brand is reported in Match.Metadata["brand"].
+type CreditCardDetector struct {
+	// Companions also reports expiry dates (12/27) and CVV values found
+	// within companionWindow bytes of a card number, as PCI DSS requires
+	// masking them alongside the PAN.
+	Companions bool
+}
+
+// imeiLabelledCardScore is the score of a card number introduced by an
+// "IMEI" label.
+const imeiLabelledCardScore = 0.5
 
 func (d *CreditCardDetector) Name() string {
 	return "global_credit_card"
 }
 
 func (d *CreditCardDetector) Scan(input string) []Match {
-	var results []Match
-	var digitsBuf [19]byte
+	return ownMetadata(d.scan(nil, input))
+}
 
-	for i := 0; i < len(input); i++ {
-		if !isDigitChar(input[i]) {
-			continue
-		}
+// ScanAppend implements AppendScanner.
+func (d *CreditCardDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
 
-		if i > 0 && isDigitChar(input[i-1]) {
+func (d *CreditCardDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	for i := 0; i < len(input); {
+		if !isDigitChar(input[i]) {
+			i++
 			continue
 		}
-
-		start := i
-		count := 0
-		j := i
-
-		for j < len(input) && count < 19 {
-			c := input[j]
-			switch {
-			case isDigitChar(c):
-				digitsBuf[count] = c
-				count++
-			case (c == ' ' || c == '-') && count > 0:
-				// allow separator
-			default:
-				goto evaluate
-			}
-			j++
+		end, brand, score, next := matchCardAt(input, i)
+		if brand != "" {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeCreditCard,
+				Score:      score,
+				Metadata:   cardBrandMetadata[brand],
+			})
 		}
+		i = next
+	}
 
-	evaluate:
-		if count >= 13 && count <= 19 {
-			if j < len(input) && isDigitChar(input[j]) {
-				// part of longer sequence, skip
-				i = j
-				continue
-			}
-			if isValidLuhnBytes(digitsBuf[:count]) {
-				results = append(results, Match{
-					StartIndex: start,
-					EndIndex:   j,
-					Value:      input[start:j],
-					Type:       TypeCreditCard,
-					Score:      1.0,
-				})
-				i = j - 1
-				continue
-			}
-		}
+	if d.Companions && len(results) > len(dst) {
+		cards := appendCardCompanions(results[len(dst):], input)
+		results = append(results[:len(dst)], cards...)
 	}
 
 	return results
@@ -65,6 +60,58 @@ func NewCreditCardDetector() Detector {
 	return &CreditCardDetector{}
 }
 
+// matchCardAt looks for a card number starting at the digit input[i] and
+// returns its end, brand (empty if there is none) and score, and where the
+// scan resumes.
+func matchCardAt(input string, i int) (end int, brand string, score float32, next int) {
+	if i > 0 && isDigitChar(input[i-1]) {
+		return 0, "", 0, i + 1
+	}
+
+	var digitsBuf [19]byte
+	count := 0
+	j := i
+	end = i // right after the last digit, so trailing separators stay unmasked
+
+	for j < len(input) && count < 19 {
+		c := input[j]
+		switch {
+		case isDigitChar(c):
+			digitsBuf[count] = c
+			count++
+			end = j + 1
+		case (c == ' ' || c == '-') && count > 0:
+			// allow separator
+		default:
+			goto evaluate
+		}
+		j++
+	}
+
+evaluate:
+	if count < 13 || count > 19 {
+		return 0, "", 0, i + 1
+	}
+	if j < len(input) && isDigitChar(input[j]) {
+		// part of longer sequence, skip
+		return 0, "", 0, j + 1
+	}
+	brand = cardBrand(digitsBuf[:count])
+	if brand == "" || !isValidLuhnBytes(digitsBuf[:count]) {
+		return 0, "", 0, i + 1
+	}
+	score = 1.0
+	if keywordBefore(input, i, imeiKeywordWindow, imeiKeywords) != "" {
+		// A labelled 15-digit number is an IMEI. Other lengths stay cards,
+		// with a lower score so that an IMEISV match wins the overlap.
+		if count == 15 {
+			return 0, "", 0, i + 1
+		}
+		score = imeiLabelledCardScore
+	}
+	return end, brand, score, end
+}
+
 // isValidLuhnBytes implements the Luhn algorithm without allocations.
 func isValidLuhnBytes(number []byte) bool {
 	sum := 0
@@ -1,6 +1,7 @@
 package detectors
 
 import (
+	"strings"
 	"sync"
 	"testing"
 )
@@ -19,6 +20,13 @@ func TestCreditCardDetector(t *testing.T) {
 		{"Valid Amex", "Use 371449635398431 for tests", 1}, // 15 digits
 		{"Valid 13 Digits", "Old Visa 4222222222222", 1},   // 13 digits
 		{"Multiple Cards", "Primary 4242 4242 4242 4242, backup 5555-5555-5555-4444", 2},
+		{"Valid Mastercard 2-Series", "Card 2223 0000 4840 0011", 1},
+		{"Valid Elo", "Cartão Elo 6362 9700 0045 7013", 1},
+		{"Valid Hipercard", "Hipercard 6062 8256 2425 4001", 1},
+		{"Valid Discover", "Discover 6011 1111 1111 1117", 1},
+		{"Valid JCB", "JCB 3530 1113 3330 0000", 1},
+		{"Valid UnionPay", "UnionPay 6200 0000 0000 0005", 1},
+		{"Valid Diners", "Diners 3622 720627 1667", 1},
 
 		// Invalid Cases (Luhn Algorithm / Logic)
 		{"Invalid Luhn", "4111 1111 1111 1112", 0}, // Checksum fail
@@ -28,6 +36,11 @@ func TestCreditCardDetector(t *testing.T) {
 		// Formatting & Noise Edge Cases
 		{"Letters Mixed", "4111a1111b1111c111", 0},                 // Should break sequence
 		{"Numeric Noise", "Order #1234567890123456 is invalid", 0}, // Likely fails Luhn
+		{"Luhn Valid Unknown IIN", "Order #1234567890123452 shipped", 0},
+		{"Luhn Valid IMEI", "IMEI 490154203237518", 0},           // Visa prefix, but 15 digits
+		{"Luhn Valid IMEI JCB Range", "IMEI 352099001761481", 0}, // JCB prefix, but 15 digits
+		{"16-Digit Card After IMEI Label", "imei: 4111111111111111", 1},
+		{"Amex Wrong Length", "Card 3714496353984310", 0},
 		{"Boundary Start", "4111111111111111 is the card", 1},
 		{"Boundary End", "The card is 4111111111111111", 1},
 		{"Unicode Noise", "Card 4111 1111 1111 1111 🚀", 1},
@@ -43,6 +56,105 @@ func TestCreditCardDetector(t *testing.T) {
 	}
 }
 
+func TestCreditCardDetector_Brand(t *testing.T) {
+	d := NewCreditCardDetector()
+
+	tests := []struct {
+		input string
+		brand string
+	}{
+		{"4111 1111 1111 1111", CardBrandVisa},
+		{"5555-5555-5555-4444", CardBrandMastercard},
+		{"2223000048400011", CardBrandMastercard},
+		{"371449635398431", CardBrandAmex},
+		{"6362970000457013", CardBrandElo},
+		{"6062825624254001", CardBrandHipercard},
+		{"6011111111111117", CardBrandDiscover},
+		{"3530111333300000", CardBrandJCB},
+		{"6200000000000005", CardBrandUnionPay},
+		{"36227206271667", CardBrandDiners},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.brand, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if len(matches) != 1 {
+				t.Fatalf("input: %q\nexpected 1 match, got %d", tt.input, len(matches))
+			}
+			if got := matches[0].Metadata["brand"]; got != tt.brand {
+				t.Errorf("input: %q\nexpected brand %q, got %q", tt.input, tt.brand, got)
+			}
+		})
+	}
+}
+
+func TestCreditCardDetector_Companions(t *testing.T) {
+	d := &CreditCardDetector{Companions: true}
+
+	tests := []struct {
+		name  string
+		input string
+		want  map[PIIType]string
+	}{
+		{
+			"Expiry And CVV",
+			"Card 4111 1111 1111 1111 exp 12/27 CVV: 123",
+			map[PIIType]string{TypeCreditCard: "4111 1111 1111 1111", TypeCardExpiry: "12/27", TypeCVV: "123"},
+		},
+		{
+			"Four Digit Year And Amex CID",
+			"Amex 371449635398431, validade 08/2029, CID 1234",
+			map[PIIType]string{TypeCreditCard: "371449635398431", TypeCardExpiry: "08/2029", TypeCVV: "1234"},
+		},
+		{
+			"Portuguese Keyword",
+			"Cartão 5555-5555-5555-4444 código de segurança 987",
+			map[PIIType]string{TypeCreditCard: "5555-5555-5555-4444", TypeCVV: "987"},
+		},
+		{
+			"Full Date Is Not Expiry",
+			"Card 4111 1111 1111 1111 charged on 12/05/2027",
+			map[PIIType]string{TypeCreditCard: "4111 1111 1111 1111"},
+		},
+		{
+			"CVV Without Keyword",
+			"Card 4111 1111 1111 1111 order 123",
+			map[PIIType]string{TypeCreditCard: "4111 1111 1111 1111"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if len(matches) != len(tt.want) {
+				t.Fatalf("input: %q\nexpected %d matches, got %d: %+v", tt.input, len(tt.want), len(matches), matches)
+			}
+			for _, m := range matches {
+				if want, ok := tt.want[m.Type]; !ok || m.Value != want {
+					t.Errorf("unexpected %s match %q", m.Type, m.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestCreditCardDetector_CompanionsRequireCard(t *testing.T) {
+	d := &CreditCardDetector{Companions: true}
+
+	far := "exp 12/27 CVV 123" + strings.Repeat(" filler", 20) + " card 4111 1111 1111 1111"
+	inputs := []string{
+		"Meeting on 12/27, CVV 123 was mentioned",
+		far,
+	}
+	for _, input := range inputs {
+		for _, m := range d.Scan(input) {
+			if m.Type != TypeCreditCard {
+				t.Errorf("input: %q\nunexpected companion %s %q", input, m.Type, m.Value)
+			}
+		}
+	}
+}
+
 // 2. Concurrency Test (Thread-Safety)
 func TestCreditCardDetector_Concurrency(t *testing.T) {
 	d := NewCreditCardDetector()
@@ -108,3 +220,14 @@ Please mask all before logging.
 		_ = d.Scan(payload)
 	}
 }
+
+func TestCreditCardDetector_MetadataOwned(t *testing.T) {
+	d := NewCreditCardDetector()
+	input := "Card 4111 1111 1111 1111"
+
+	first := d.Scan(input)
+	first[0].Metadata["brand"] = "changed"
+	if got := d.Scan(input)[0].Metadata["brand"]; got != CardBrandVisa {
+		t.Errorf("metadata leaked between scans: brand %q", got)
+	}
+}
@@ -0,0 +1,115 @@
+package detectors
+
+type CUITDetector struct{}
+
+func (d *CUITDetector) Name() string {
+	return "ar_cuit"
+}
+
+func (d *CUITDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *CUITDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *CUITDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [11]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		start := i
+		count := 0
+		j := i
+
+		for j < len(input) && count < 11 {
+			c := input[j]
+			switch {
+			case isDigitChar(c):
+				digits[count] = c
+				count++
+			case c == '-' || c == ' ' || c == '.':
+				if !validCUITSeparatorPosition(c, count) {
+					goto nextCandidate
+				}
+			default:
+				goto evaluate
+			}
+			j++
+		}
+
+	evaluate:
+		if count == 11 {
+			if j < len(input) && isDigitChar(input[j]) {
+				goto nextCandidate
+			}
+			if isValidCUITBytes(digits[:]) {
+				results = append(results, Match{
+					StartIndex: start,
+					EndIndex:   j,
+					Value:      input[start:j],
+					Type:       TypeCUIT,
+					Score:      1.0,
+				})
+				i = j - 1
+				continue
+			}
+		}
+
+	nextCandidate:
+	}
+
+	return results
+}
+
+// NewCUITDetector detects Argentine CUIT/CUIL numbers (XX-XXXXXXXX-X).
+func NewCUITDetector() Detector {
+	return &CUITDetector{}
+}
+
+func validCUITSeparatorPosition(sep byte, count int) bool {
+	switch sep {
+	case '-', ' ':
+		return count == 2 || count == 10
+	case '.':
+		// Some registries print the DNI portion with dots: 20-12.345.678-6
+		return count == 4 || count == 7
+	default:
+		return false
+	}
+}
+
+// isValidCUITBytes checks the type prefix and the Mod11 check digit.
+func isValidCUITBytes(cuit []byte) bool {
+	switch int(cuit[0]-'0')*10 + int(cuit[1]-'0') {
+	case 20, 23, 24, 27, 30, 33, 34:
+	default:
+		return false
+	}
+
+	weights := [10]int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		sum += int(cuit[i]-'0') * weights[i]
+	}
+
+	digit := 11 - sum%11
+	switch digit {
+	case 11:
+		digit = 0
+	case 10:
+		// AFIP reassigns these under prefix 23; a 10 is never issued.
+		return false
+	}
+
+	return int(cuit[10]-'0') == digit
+}
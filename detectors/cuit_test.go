@@ -0,0 +1,70 @@
+package detectors
+
+import "testing"
+
+func TestCUITDetector(t *testing.T) {
+	d := NewCUITDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Formatted", "CUIT: 20-12345678-6 registrado.", 1},
+		{"Valid CUIL", "CUIL 27-28033514-8", 1},
+		{"Valid Company", "Razón social 30-71234567-1", 1},
+		{"Valid Plain", "Doc 20123456786", 1},
+		{"Valid Spaces", "CUIT 20 12345678 6", 1},
+		{"Valid Dotted DNI", "CUIT 20-12.345.678-6", 1},
+
+		// Invalid Cases
+		{"Invalid Checksum", "CUIT 20-12345678-5", 0},
+		{"Unknown Prefix", "CUIT 21-12345678-6", 0},
+		{"Wrong Separator Position", "CUIT 201-2345678-6", 0},
+		{"Too Long", "201234567861", 0},
+		{"Short Sequence", "20-1234567-6", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "CUIT 20-12345678-6 🚀", 1},
+		{"Boundary Start", "20-12345678-6 es el CUIT", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzCUIT -fuzztime=10s
+func FuzzCUITDetector(f *testing.F) {
+	d := NewCUITDetector()
+
+	f.Add("20-12345678-6")
+	f.Add("20123456786")
+	f.Add("Random text 20-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
+
+func BenchmarkCUITDetector_LongText(b *testing.B) {
+	d := NewCUITDetector()
+	payload := `
+Padrón:
+Cliente A CUIT 20-12345678-6
+Cliente B CUIL 27-28033514-8
+Empresa C CUIT 30-71234567-1
+`
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.Scan(payload)
+	}
+}
@@ -0,0 +1,116 @@
+package detectors
+
+type CURPDetector struct{}
+
+func (d *CURPDetector) Name() string {
+	return "mx_curp"
+}
+
+func (d *CURPDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *CURPDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *CURPDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+18 <= len(input); i++ {
+		if !isUpperLetter(input[i]) {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		end := i + 18
+		if end < len(input) && isAlnumChar(input[end]) {
+			continue
+		}
+
+		if isValidCURP(input[i:end]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeCURP,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewCURPDetector detects Mexican CURP population registry codes.
+func NewCURPDetector() Detector {
+	return &CURPDetector{}
+}
+
+// isValidCURP checks the 18-char layout
+// (AAAA YYMMDD S EE CCC H D: name, birth date, sex, state, consonants,
+// homoclave and check digit) and the Mod10 check digit.
+func isValidCURP(s string) bool {
+	for k := 0; k < 4; k++ {
+		if !isUpperLetter(s[k]) {
+			return false
+		}
+	}
+	var date [6]byte
+	copy(date[:], s[4:10])
+	if !isValidYYMMDD(date[:]) {
+		return false
+	}
+	if s[10] != 'H' && s[10] != 'M' && s[10] != 'X' {
+		return false
+	}
+	if !isCURPState(s[11], s[12]) {
+		return false
+	}
+	for k := 13; k < 16; k++ {
+		if !isUpperLetter(s[k]) {
+			return false
+		}
+	}
+	if !isUpperLetter(s[16]) && !isDigitChar(s[16]) {
+		return false
+	}
+	if !isDigitChar(s[17]) {
+		return false
+	}
+
+	// Alphabet "0123456789ABCDEFGHIJKLMNÑOPQRSTUVWXYZ": letters after N shift by one.
+	sum := 0
+	for k := 0; k < 17; k++ {
+		c := s[k]
+		v := 0
+		switch {
+		case isDigitChar(c):
+			v = int(c - '0')
+		case c <= 'N':
+			v = int(c-'A') + 10
+		default:
+			v = int(c-'A') + 11
+		}
+		sum += v * (18 - k)
+	}
+	digit := (10 - sum%10) % 10
+
+	return int(s[17]-'0') == digit
+}
+
+// curpStates lists the two-letter federal entity codes, NE being foreign-born.
+const curpStates = "ASBCBSCCCLCMCSCHDFDGGTGRHGJCMCMNMSNTNLOCPLQTQRSPSLSRTCTSTLVZYNZSNE"
+
+func isCURPState(a, b byte) bool {
+	for k := 0; k+1 < len(curpStates); k += 2 {
+		if curpStates[k] == a && curpStates[k+1] == b {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package detectors
+
+import "testing"
+
+func TestCURPDetector(t *testing.T) {
+	d := NewCURPDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid", "CURP: GOMC850812HDFNRR04 vigente.", 1},
+		{"Valid Second", "CURP BADD110313HCMLNS06", 1},
+
+		// Invalid Cases
+		{"Invalid Checksum", "CURP GOMC850812HDFNRR05", 0},
+		{"Invalid Date", "CURP GOMC851312HDFNRR04", 0},
+		{"Invalid Sex", "CURP GOMC850812ZDFNRR04", 0},
+		{"Invalid State", "CURP GOMC850812HZZNRR04", 0},
+		{"Lowercase", "curp gomc850812hdfnrr04", 0},
+		{"Too Long", "CURP GOMC850812HDFNRR041", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "CURP GOMC850812HDFNRR04 🚀", 1},
+		{"Boundary Start", "GOMC850812HDFNRR04 es la CURP", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzCURP -fuzztime=10s
+func FuzzCURPDetector(f *testing.F) {
+	d := NewCURPDetector()
+
+	f.Add("GOMC850812HDFNRR04")
+	f.Add("AAAA000000HDF")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,319 @@
+package detectors
+
+// DateOrder tells how to read an all-numeric date such as 03/04/1985.
+type DateOrder uint8
+
+const (
+	DateOrderDMY DateOrder = iota // 03/04/1985 is 3 April (most of the world)
+	DateOrderMDY                  // 03/04/1985 is March 4 (US)
+)
+
+// DateOrderForLocale returns the numeric date order used by a BCP 47 locale
+// such as "pt-BR" or "en-US". Unknown locales read day first.
+func DateOrderForLocale(locale string) DateOrder {
+	switch {
+	case hasPrefixFold(locale, "en-us"), hasPrefixFold(locale, "en_us"),
+		hasPrefixFold(locale, "en-ph"), hasPrefixFold(locale, "en_ph"),
+		hasPrefixFold(locale, "fil"):
+		return DateOrderMDY
+	}
+	return DateOrderDMY
+}
+
+// DateDetector finds calendar-valid dates written as ISO (1985-03-12),
+// numeric (12/03/1985, 12.03.1985, 12-03-1985) or in English or Portuguese
+// words ("March 12, 1985", "12th of March 1985", "12 de março de 1985").
+//
+// By default only birth dates are reported: a keyword such as "DOB", "born"
+// or "nascimento" must appear shortly before the date. With AllDates every
+// date is reported, as DATE unless it carries a birth keyword.
+type DateDetector struct {
+	// Order resolves numeric dates. When a date is invalid in this order but
+	// unambiguous the other way round (e.g. 12/31/1985 under DMY), it is
+	// still accepted.
+	Order DateOrder
+
+	// AllDates reports every date, not only birth dates.
+	AllDates bool
+}
+
+// dobKeywords mark the next date as a date of birth.
+var dobKeywords = []string{
+	"dob", "d.o.b", "date of birth", "birth", "birthday", "birthdate", "born",
+	"nascimento", "nascido", "nascida", "nasc", "dt nasc",
+	"nacimiento", "nacido", "nacida",
+}
+
+// dobKeywordWindow is how far before a date the keyword may appear.
+const dobKeywordWindow = 40
+
+type monthName struct {
+	name  string
+	month int
+}
+
+// englishMonths lists full names before abbreviations so the longest wins.
+var englishMonths = []monthName{
+	{"january", 1}, {"february", 2}, {"march", 3}, {"april", 4}, {"may", 5}, {"june", 6},
+	{"july", 7}, {"august", 8}, {"september", 9}, {"october", 10}, {"november", 11}, {"december", 12},
+	{"jan", 1}, {"feb", 2}, {"mar", 3}, {"apr", 4}, {"jun", 6}, {"jul", 7},
+	{"aug", 8}, {"sept", 9}, {"sep", 9}, {"oct", 10}, {"nov", 11}, {"dec", 12},
+}
+
+var portugueseMonths = []monthName{
+	{"janeiro", 1}, {"fevereiro", 2}, {"março", 3}, {"marco", 3}, {"abril", 4}, {"maio", 5},
+	{"junho", 6}, {"julho", 7}, {"agosto", 8}, {"setembro", 9}, {"outubro", 10}, {"novembro", 11},
+	{"dezembro", 12},
+	{"jan", 1}, {"fev", 2}, {"mar", 3}, {"abr", 4}, {"mai", 5}, {"jun", 6}, {"jul", 7},
+	{"ago", 8}, {"set", 9}, {"out", 10}, {"nov", 11}, {"dez", 12},
+}
+
+func (d *DateDetector) Name() string {
+	return "date"
+}
+
+func (d *DateDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *DateDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *DateDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if i > 0 && (isAlnumChar(input[i-1]) || input[i-1] >= 0x80) {
+			continue
+		}
+
+		var end int
+		var ok bool
+		switch {
+		case isDigitChar(c):
+			if i > 1 && isDateSeparator(input[i-1]) && isDigitChar(input[i-2]) {
+				continue
+			}
+			end, ok = d.matchNumericDate(input, i)
+			if !ok {
+				end, ok = matchWrittenDate(input, i)
+			}
+		case isLetter(c):
+			end, ok = matchMonthFirstDate(input, i)
+		default:
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		typ, score := TypeDateOfBirth, float32(1.0)
+		if keywordBefore(input, i, dobKeywordWindow, dobKeywords) == "" {
+			if !d.AllDates {
+				i = end - 1
+				continue
+			}
+			typ, score = TypeDate, 0.6
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       typ,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewDateDetector() Detector {
+	return &DateDetector{}
+}
+
+func isDateSeparator(c byte) bool {
+	return c == '/' || c == '-' || c == '.'
+}
+
+// readDateNumber reads up to max digits at i.
+func readDateNumber(input string, i, max int) (value, end int) {
+	end = i
+	for end < len(input) && end-i < max && isDigitChar(input[end]) {
+		value = value*10 + int(input[end]-'0')
+		end++
+	}
+	return value, end
+}
+
+func isValidDate(year, month, day int) bool {
+	return year >= 1900 && year <= 2099 && month >= 1 && month <= 12 &&
+		day >= 1 && day <= daysInMonth(year, month)
+}
+
+// matchNumericDate matches yyyy-mm-dd or a day/month pair followed by a
+// 4-digit year, all sharing one separator.
+func (d *DateDetector) matchNumericDate(input string, i int) (int, bool) {
+	a, j := readDateNumber(input, i, 4)
+	if j == i || j >= len(input) || !isDateSeparator(input[j]) {
+		return 0, false
+	}
+	sep := input[j]
+	lenA := j - i
+
+	b, k := readDateNumber(input, j+1, 2)
+	if k == j+1 || k >= len(input) || input[k] != sep {
+		return 0, false
+	}
+	c, end := readDateNumber(input, k+1, 4)
+	lenC := end - (k + 1)
+
+	// Part of a longer number, version string or IP address
+	if end < len(input) && (isDigitChar(input[end]) ||
+		(isDateSeparator(input[end]) && end+1 < len(input) && isDigitChar(input[end+1]))) {
+		return 0, false
+	}
+
+	switch {
+	case lenA == 4 && lenC >= 1 && lenC <= 2:
+		return end, isValidDate(a, b, c)
+	case lenA <= 2 && lenC == 4:
+		day, month := a, b
+		if d.Order == DateOrderMDY {
+			day, month = b, a
+		}
+		if isValidDate(c, month, day) {
+			return end, true
+		}
+		// Unambiguous the other way round: the would-be month is > 12
+		return end, month > 12 && isValidDate(c, day, month)
+	}
+	return 0, false
+}
+
+// matchMonthName matches one of names at i, followed by an optional '.' and
+// a non-letter.
+func matchMonthName(input string, i int, names []monthName) (month, end int) {
+	for _, m := range names {
+		if !hasPrefixFold(input[i:], m.name) {
+			continue
+		}
+		end = i + len(m.name)
+		if end < len(input) && (isLetter(input[end]) || input[end] >= 0x80) {
+			continue
+		}
+		if end < len(input) && input[end] == '.' {
+			end++
+		}
+		return m.month, end
+	}
+	return 0, 0
+}
+
+func skipDateSpaces(input string, i int) int {
+	for i < len(input) && input[i] == ' ' {
+		i++
+	}
+	return i
+}
+
+// matchWord matches a lowercase word at i followed by a space.
+func matchWord(input string, i int, word string) (int, bool) {
+	if !hasPrefixFold(input[i:], word) || i+len(word) >= len(input) || input[i+len(word)] != ' ' {
+		return i, false
+	}
+	return skipDateSpaces(input, i+len(word)), true
+}
+
+// readDateYear reads a 4-digit year not followed by another digit.
+func readDateYear(input string, i int) (int, int, bool) {
+	year, end := readDateNumber(input, i, 4)
+	if end-i != 4 || (end < len(input) && isDigitChar(input[end])) {
+		return 0, 0, false
+	}
+	return year, end, true
+}
+
+// matchWrittenDate matches "12 March 1985", "12th of March, 1985" and
+// "12 de março de 1985" / "1º de maio de 1985".
+func matchWrittenDate(input string, i int) (int, bool) {
+	day, j := readDateNumber(input, i, 2)
+	if j < len(input) && isDigitChar(input[j]) {
+		return 0, false
+	}
+
+	// Ordinal suffix: 1st, 2nd, 3rd, 4th, 1º (U+00BA)
+	switch {
+	case hasPrefixFold(input[j:], "st"), hasPrefixFold(input[j:], "nd"),
+		hasPrefixFold(input[j:], "rd"), hasPrefixFold(input[j:], "th"):
+		j += 2
+	case hasPrefixFold(input[j:], "º"):
+		j += len("º")
+	}
+	if j >= len(input) || input[j] != ' ' {
+		return 0, false
+	}
+	j = skipDateSpaces(input, j)
+
+	// Portuguese: D de <mês> de YYYY
+	if k, ok := matchWord(input, j, "de"); ok {
+		month, k := matchMonthName(input, k, portugueseMonths)
+		if month == 0 {
+			return 0, false
+		}
+		k = skipDateSpaces(input, k)
+		if k, ok = matchWord(input, k, "de"); !ok {
+			return 0, false
+		}
+		year, end, ok := readDateYear(input, k)
+		return end, ok && isValidDate(year, month, day)
+	}
+
+	// English: D [of] Month[,] YYYY
+	if k, ok := matchWord(input, j, "of"); ok {
+		j = k
+	}
+	month, k := matchMonthName(input, j, englishMonths)
+	if month == 0 {
+		return 0, false
+	}
+	if k < len(input) && input[k] == ',' {
+		k++
+	}
+	k = skipDateSpaces(input, k)
+	year, end, ok := readDateYear(input, k)
+	return end, ok && isValidDate(year, month, day)
+}
+
+// matchMonthFirstDate matches "March 12, 1985", "Mar. 12th 1985".
+func matchMonthFirstDate(input string, i int) (int, bool) {
+	month, j := matchMonthName(input, i, englishMonths)
+	if month == 0 || j >= len(input) || input[j] != ' ' {
+		return 0, false
+	}
+	j = skipDateSpaces(input, j)
+
+	day, k := readDateNumber(input, j, 2)
+	if k == j || (k < len(input) && isDigitChar(input[k])) {
+		return 0, false
+	}
+	switch {
+	case hasPrefixFold(input[k:], "st"), hasPrefixFold(input[k:], "nd"),
+		hasPrefixFold(input[k:], "rd"), hasPrefixFold(input[k:], "th"):
+		k += 2
+	}
+	if k < len(input) && input[k] == ',' {
+		k++
+	}
+	if k >= len(input) || input[k] != ' ' {
+		return 0, false
+	}
+	k = skipDateSpaces(input, k)
+
+	year, end, ok := readDateYear(input, k)
+	return end, ok && isValidDate(year, month, day)
+}
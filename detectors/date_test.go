@@ -0,0 +1,112 @@
+package detectors
+
+import "testing"
+
+func TestDateDetector(t *testing.T) {
+	d := NewDateDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Numeric forms with a birth keyword
+		{"ISO", "DOB: 1985-03-12", []string{"1985-03-12"}},
+		{"ISO Datetime", "born 1985-03-12T08:30:00Z", []string{"1985-03-12"}},
+		{"DMY Slash", "Data de nascimento: 12/03/1985", []string{"12/03/1985"}},
+		{"DMY Dot", "Geburtsdatum / date of birth 12.03.1985", []string{"12.03.1985"}},
+		{"Unambiguous MDY", "DOB 03/31/1985", []string{"03/31/1985"}},
+		{"Birthday", "Birthday: 03/31/1985", []string{"03/31/1985"}},
+
+		// Written forms
+		{"English Month First", "Born on March 12, 1985 in Ohio", []string{"March 12, 1985"}},
+		{"English Abbrev", "DOB: Mar. 12th 1985", []string{"Mar. 12th 1985"}},
+		{"English Day First", "date of birth: 12th of March, 1985", []string{"12th of March, 1985"}},
+		{"Portuguese", "nascido em 12 de março de 1985", []string{"12 de março de 1985"}},
+		{"Portuguese Ordinal", "Nascimento: 1º de maio de 1990", []string{"1º de maio de 1990"}},
+
+		// Calendar validation
+		{"Feb 29 Leap", "DOB 29/02/1988", []string{"29/02/1988"}},
+		{"Feb 29 Non Leap", "DOB 29/02/1987", nil},
+		{"Month 13", "DOB 1985-13-01", nil},
+		{"Day 32", "DOB March 32, 1985", nil},
+		{"Year Out Of Range", "DOB 12/03/1885", nil},
+
+		// Context
+		{"No Keyword", "Invoice dated 12/03/2024", nil},
+		{"Keyword Too Far", "born in a small town far away from everything, 12/03/1985", nil},
+
+		// Not dates
+		{"Version", "DOB v1.2.2024.1", nil},
+		{"IP Address", "born 10.12.1985.3", nil},
+		{"Mixed Separators", "DOB 12/03-1985", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if len(matches) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %d matches, got %d: %+v", tt.input, len(tt.expected), len(matches), matches)
+			}
+			for k, m := range matches {
+				if m.Value != tt.expected[k] || m.Type != TypeDateOfBirth {
+					t.Errorf("match %d: expected DATE_OF_BIRTH %q, got %s %q", k, tt.expected[k], m.Type, m.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestDateDetector_Order(t *testing.T) {
+	input := "DOB 03/04/1985"
+
+	for _, tt := range []struct {
+		order DateOrder
+		valid string
+	}{
+		{DateOrderDMY, "DOB 31/12/1985"},
+		{DateOrderMDY, "DOB 12/31/1985"},
+	} {
+		d := &DateDetector{Order: tt.order}
+		if got := d.Scan(input); len(got) != 1 {
+			t.Errorf("order %d: expected ambiguous date to match, got %d", tt.order, len(got))
+		}
+		if got := d.Scan(tt.valid); len(got) != 1 {
+			t.Errorf("order %d: expected %q to match, got %d", tt.order, tt.valid, len(got))
+		}
+	}
+
+	if DateOrderForLocale("en-US") != DateOrderMDY || DateOrderForLocale("pt-BR") != DateOrderDMY {
+		t.Error("unexpected locale order")
+	}
+}
+
+func TestDateDetector_AllDates(t *testing.T) {
+	d := &DateDetector{AllDates: true}
+
+	matches := d.Scan("Invoice 2024-01-15, customer born 12/03/1985.")
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches, got %d: %+v", len(matches), matches)
+	}
+	if matches[0].Type != TypeDate || matches[1].Type != TypeDateOfBirth {
+		t.Errorf("unexpected types %s, %s", matches[0].Type, matches[1].Type)
+	}
+	if matches[0].Score >= matches[1].Score {
+		t.Errorf("plain date should score below a birth date")
+	}
+}
+
+// Run with: go test -fuzz=FuzzDate -fuzztime=10s
+func FuzzDateDetector(f *testing.F) {
+	d := &DateDetector{AllDates: true}
+
+	f.Add("DOB 12/03/1985")
+	f.Add("12 de março de 1985")
+	f.Add("March 12, 1985")
+	f.Add("1º de")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
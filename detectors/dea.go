@@ -0,0 +1,83 @@
+package detectors
+
+// DEADetector finds DEA registration numbers: a registrant-type letter, the
+// registrant's last-name initial (or 9 for business names) and 7 digits with
+// a checksum. The "DEA" keyword raises the score.
+type DEADetector struct{}
+
+func (d *DEADetector) Name() string {
+	return "us_dea"
+}
+
+var deaKeywords = []string{"dea"}
+
+// deaRegistrantTypes are the allowed first letters.
+const deaRegistrantTypes = "ABCDEFGHJKLMPRSTUX"
+
+func (d *DEADetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *DEADetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *DEADetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+9 <= len(input); i++ {
+		if !isUpperLetter(input[i]) || (i > 0 && isAlnumChar(input[i-1])) {
+			continue
+		}
+		end := i + 9
+		if end < len(input) && isAlnumChar(input[end]) {
+			continue
+		}
+		if !isValidDEA(input[i:end]) {
+			continue
+		}
+
+		score := float32(0.9)
+		if keywordBefore(input, i, healthKeywordWindow, deaKeywords) != "" {
+			score = 1.0
+		}
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeDEA,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewDEADetector() Detector {
+	return &DEADetector{}
+}
+
+// isValidDEA checks layout and checksum: (d1+d3+d5) + 2*(d2+d4+d6) must end
+// in d7.
+func isValidDEA(s string) bool {
+	found := false
+	for k := 0; k < len(deaRegistrantTypes); k++ {
+		if deaRegistrantTypes[k] == s[0] {
+			found = true
+			break
+		}
+	}
+	if !found || !(isUpperLetter(s[1]) || s[1] == '9') {
+		return false
+	}
+	for k := 2; k < 9; k++ {
+		if !isDigitChar(s[k]) {
+			return false
+		}
+	}
+	odd := int(s[2]-'0') + int(s[4]-'0') + int(s[6]-'0')
+	even := int(s[3]-'0') + int(s[5]-'0') + int(s[7]-'0')
+	return (odd+2*even)%10 == int(s[8]-'0')
+}
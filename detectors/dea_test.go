@@ -0,0 +1,61 @@
+package detectors
+
+import "testing"
+
+func TestDEADetector(t *testing.T) {
+	d := NewDEADetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Plain", "AB1234563", 1},
+		{"Keyword", "DEA# BJ6125341", 1},
+		{"Business Name", "Registrant F91234563", 1},
+
+		// Invalid Cases
+		{"Bad Check Digit", "AB1234567", 0},
+		{"Bad Registrant Type", "ZB1234563", 0},
+		{"Lowercase", "ab1234563", 0},
+		{"Too Long", "AB12345631", 0},
+		{"Glued", "XAB1234563", 0},
+
+		// Noise & Boundary
+		{"Sentence End", "Prescriber DEA: AB1234563.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDEADetector_KeywordScore(t *testing.T) {
+	d := NewDEADetector()
+
+	if m := d.Scan("DEA AB1234563"); len(m) != 1 || m[0].Score != 1.0 {
+		t.Errorf("expected keyword match with score 1.0, got %+v", m)
+	}
+	if m := d.Scan("ref AB1234563"); len(m) != 1 || m[0].Score >= 1.0 {
+		t.Errorf("expected bare match with a lower score, got %+v", m)
+	}
+}
+
+// Run with: go test -fuzz=FuzzDEA -fuzztime=10s
+func FuzzDEADetector(f *testing.F) {
+	d := NewDEADetector()
+
+	f.Add("DEA AB1234563")
+	f.Add("A9")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,92 @@
+package detectors
+
+// DeviceIDDetector finds mobile advertising identifiers: Apple IDFA/IDFV and
+// Android advertising IDs (AAID/GAID). They are UUIDs, so a label such as
+// "IDFA" or "advertising_id" is required before the value; the kind is
+// reported in Match.Metadata["kind"].
+type DeviceIDDetector struct{}
+
+func (d *DeviceIDDetector) Name() string {
+	return "global_device_id"
+}
+
+func (d *DeviceIDDetector) Scan(input string) []Match {
+	return ownMetadata(d.scan(nil, input))
+}
+
+// ScanAppend implements AppendScanner.
+func (d *DeviceIDDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *DeviceIDDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	for i := 0; i < len(input); i++ {
+		if !isHexChar(input[i]) {
+			continue
+		}
+		end, ok := matchUUID(input, i)
+		if !ok {
+			continue
+		}
+		if kind := advertisingIDKind(input, i); kind != "" {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeDeviceID,
+				Score:      1.0,
+				Metadata:   deviceIDMetadata[kind],
+			})
+		}
+		i = end - 1
+	}
+	return results
+}
+
+func NewDeviceIDDetector() Detector {
+	return &DeviceIDDetector{}
+}
+
+// deviceIDWindow is how far before the UUID the label may appear,
+// enough for `"advertising_id": "` and similar JSON or log layouts.
+const deviceIDWindow = 32
+
+// deviceIDKeywords maps labels to the identifier kind. Longer labels come
+// first so they win over their prefixes.
+var deviceIDKeywords = []string{
+	"advertising_id", "advertisingid", "advertising id", "google_ad_id",
+	"idfa", "idfv", "aaid", "gaid", "adid",
+}
+
+var deviceIDKinds = map[string]string{
+	"advertising_id": "advertising_id",
+	"advertisingid":  "advertising_id",
+	"advertising id": "advertising_id",
+	"google_ad_id":   "aaid",
+	"idfa":           "idfa",
+	"idfv":           "idfv",
+	"aaid":           "aaid",
+	"gaid":           "aaid",
+	"adid":           "aaid",
+}
+
+// deviceIDMetadata holds one shared, read-only metadata map per kind, copied
+// into the results of Scan.
+var deviceIDMetadata = map[string]map[string]string{}
+
+func init() {
+	for _, kind := range deviceIDKinds {
+		deviceIDMetadata[kind] = map[string]string{"kind": kind}
+	}
+}
+
+// advertisingIDKind returns the identifier kind when the UUID at start is
+// labelled as an advertising or vendor ID, or "" otherwise.
+func advertisingIDKind(input string, start int) string {
+	kw := keywordBefore(input, start, deviceIDWindow, deviceIDKeywords)
+	if kw == "" {
+		return ""
+	}
+	return deviceIDKinds[kw]
+}
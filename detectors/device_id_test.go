@@ -0,0 +1,69 @@
+package detectors
+
+import "testing"
+
+func TestDeviceIDDetector(t *testing.T) {
+	d := NewDeviceIDDetector()
+
+	tests := []struct {
+		name  string
+		input string
+		kind  string // "" when no match is expected
+	}{
+		{"IDFA", "IDFA: 6D92078A-8246-4BA4-AE5B-76104861E7DC", "idfa"},
+		{"IDFV", "idfv=E621E1F8-C36C-495A-93FC-0C247A3E6E5F", "idfv"},
+		{"AAID", "AAID 38400000-8cf0-11bd-b23e-10b96e40000d", "aaid"},
+		{"GAID", "gaid: cdda802e-fb9c-47ad-9866-0794d394c912", "aaid"},
+		{"JSON Field", `{"advertising_id": "38400000-8cf0-11bd-b23e-10b96e40000d"}`, "advertising_id"},
+
+		{"Plain UUID", "TraceID 123e4567-e89b-12d3-a456-426614174000", ""},
+		{"Label Too Far", "IDFA was reset yesterday, the new request trace is 123e4567-e89b-12d3-a456-426614174000", ""},
+		{"Label Inside Word", "paidfa 6D92078A-8246-4BA4-AE5B-76104861E7DC", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if tt.kind == "" {
+				if len(matches) != 0 {
+					t.Errorf("input: %q\nexpected no match, got %+v", tt.input, matches)
+				}
+				return
+			}
+			if len(matches) != 1 {
+				t.Fatalf("input: %q\nexpected 1 match, got %d", tt.input, len(matches))
+			}
+			if matches[0].Type != TypeDeviceID || matches[0].Metadata["kind"] != tt.kind {
+				t.Errorf("input: %q\nexpected %s/%s, got %s/%s", tt.input, TypeDeviceID, tt.kind, matches[0].Type, matches[0].Metadata["kind"])
+			}
+		})
+	}
+}
+
+func TestUUIDDetector_TagsDeviceIDs(t *testing.T) {
+	d := NewUUIDDetector()
+
+	matches := d.Scan("trace 123e4567-e89b-12d3-a456-426614174000 idfa 6D92078A-8246-4BA4-AE5B-76104861E7DC")
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(matches))
+	}
+	if matches[0].Type != TypeUUID {
+		t.Errorf("expected plain UUID, got %s", matches[0].Type)
+	}
+	if matches[1].Type != TypeDeviceID {
+		t.Errorf("expected labelled UUID to be a device ID, got %s", matches[1].Type)
+	}
+}
+
+// Run with: go test -fuzz=FuzzDeviceID -fuzztime=10s
+func FuzzDeviceIDDetector(f *testing.F) {
+	d := NewDeviceIDDetector()
+
+	f.Add("IDFA: 6D92078A-8246-4BA4-AE5B-76104861E7DC")
+	f.Add("advertising_id")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
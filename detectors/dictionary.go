@@ -0,0 +1,403 @@
+package detectors
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DictionaryDetector finds the terms of a word list (customer companies,
+// project codenames, VIP names) in a single pass over the input, whatever
+// the number of terms, using an Aho–Corasick automaton.
+//
+// By default matching ignores case and accents ("joao" finds "João"),
+// treats any run of whitespace as one space and only accepts whole words.
+// Build it with NewDictionary and swap the list with Update.
+type DictionaryDetector struct {
+	name          string
+	typ           PIIType
+	score         float32
+	caseSensitive bool
+	keepAccents   bool
+	partialWords  bool
+
+	auto atomic.Pointer[dictAutomaton]
+}
+
+// DictionaryEntry is a dictionary term and the type reported for it. An
+// empty Type uses the type of the detector.
+type DictionaryEntry struct {
+	Term string
+	Type PIIType
+}
+
+// DictionaryOption configures a DictionaryDetector.
+type DictionaryOption func(*DictionaryDetector)
+
+// NewDictionary builds a detector reporting entries as matches. typ is used
+// for entries without a Type.
+//
+//	vips := detectors.NewDictionary("vip_names", detectors.NewPIIType("vip"),
+//		detectors.DictionaryTerms("João Silva", "Ana Costa"))
+func NewDictionary(name string, typ PIIType, entries []DictionaryEntry, opts ...DictionaryOption) *DictionaryDetector {
+	d := &DictionaryDetector{name: name, typ: typ, score: 1.0}
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.Update(entries)
+	return d
+}
+
+// DictionaryTerms returns entries for terms, all of the detector's type.
+func DictionaryTerms(terms ...string) []DictionaryEntry {
+	entries := make([]DictionaryEntry, len(terms))
+	for i, t := range terms {
+		entries[i].Term = t
+	}
+	return entries
+}
+
+// DictionaryCaseSensitive matches terms with their exact case.
+func DictionaryCaseSensitive() DictionaryOption {
+	return func(d *DictionaryDetector) { d.caseSensitive = true }
+}
+
+// DictionaryKeepAccents matches accented letters only with themselves.
+func DictionaryKeepAccents() DictionaryOption {
+	return func(d *DictionaryDetector) { d.keepAccents = true }
+}
+
+// DictionaryPartialWords also reports terms inside longer words.
+func DictionaryPartialWords() DictionaryOption {
+	return func(d *DictionaryDetector) { d.partialWords = true }
+}
+
+// DictionaryScore sets the score of matches (1.0 by default).
+func DictionaryScore(score float32) DictionaryOption {
+	return func(d *DictionaryDetector) { d.score = score }
+}
+
+func (d *DictionaryDetector) Name() string {
+	return d.name
+}
+
+// Type returns the type reported for entries without one.
+func (d *DictionaryDetector) Type() PIIType {
+	return d.typ
+}
+
+// Len returns the number of distinct terms.
+func (d *DictionaryDetector) Len() int {
+	return len(d.auto.Load().entries)
+}
+
+// Update replaces the terms. The new automaton is built in time linear in
+// the total length of the terms and swapped in atomically, so concurrent
+// scans see either the old list or the new one.
+func (d *DictionaryDetector) Update(entries []DictionaryEntry) {
+	d.auto.Store(d.build(entries))
+}
+
+func (d *DictionaryDetector) Scan(input string) []Match {
+	a := d.auto.Load()
+	if len(a.entries) == 0 || len(input) == 0 {
+		return nil
+	}
+
+	// ring holds the original span of the last maxDepth folded bytes, so a
+	// match ending here can be mapped back to the input.
+	ring := make([]dictSpan, a.maxDepth)
+	var buf [2 * utf8.UTFMax]byte
+	var found []Match
+	state := int32(0)
+	pos := 0
+
+	for i := 0; i < len(input); {
+		folded, size := d.foldNext(buf[:0], input, i)
+		for _, b := range folded {
+			ring[pos%len(ring)] = dictSpan{i, i + size}
+			pos++
+			state = a.step(state, b)
+
+			s := state
+			if a.states[s].out < 0 {
+				s = a.states[s].dict
+			}
+			for ; s > 0; s = a.states[s].dict {
+				depth := int(a.states[s].depth)
+				start := ring[(pos-depth)%len(ring)].start
+				end := ring[(pos-1)%len(ring)].end
+				if !d.partialWords && !isDictBoundary(input, start, end) {
+					continue
+				}
+				e := &a.entries[a.states[s].out]
+				found = append(found, Match{
+					StartIndex: start,
+					EndIndex:   end,
+					Value:      input[start:end],
+					Type:       e.typ,
+					Score:      d.score,
+					Metadata:   e.meta,
+				})
+			}
+		}
+		i += size
+	}
+
+	return ownMetadata(leftmostLongest(found))
+}
+
+type dictSpan struct {
+	start, end int
+}
+
+// leftmostLongest keeps, among overlapping matches, the one starting first
+// and then the longest.
+func leftmostLongest(found []Match) []Match {
+	if len(found) < 2 {
+		return found
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].StartIndex != found[j].StartIndex {
+			return found[i].StartIndex < found[j].StartIndex
+		}
+		return found[i].EndIndex > found[j].EndIndex
+	})
+	n := 0
+	for _, m := range found {
+		if n > 0 && m.StartIndex < found[n-1].EndIndex {
+			continue
+		}
+		found[n] = m
+		n++
+	}
+	return found[:n]
+}
+
+// isDictBoundary reports whether [start, end) is not part of a longer word.
+// Terms starting or ending with punctuation ("@acme") only need a boundary
+// on their word side.
+func isDictBoundary(input string, start, end int) bool {
+	first, _ := utf8.DecodeRuneInString(input[start:])
+	before, _ := utf8.DecodeLastRuneInString(input[:start])
+	if start > 0 && isDictWordRune(first) && isDictWordRune(before) {
+		return false
+	}
+	last, _ := utf8.DecodeLastRuneInString(input[:end])
+	after, _ := utf8.DecodeRuneInString(input[end:])
+	if end < len(input) && isDictWordRune(last) && isDictWordRune(after) {
+		return false
+	}
+	return true
+}
+
+func isDictWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+// foldNext folds the rune at input[i] into dst and returns the bytes of
+// input it covers. Whitespace runs fold to one space and, unless accents
+// are kept, combining marks are folded together with their base letter.
+func (d *DictionaryDetector) foldNext(dst []byte, input string, i int) ([]byte, int) {
+	r, size := utf8.DecodeRuneInString(input[i:])
+	if unicode.IsSpace(r) {
+		for i+size < len(input) {
+			next, n := utf8.DecodeRuneInString(input[i+size:])
+			if !unicode.IsSpace(next) {
+				break
+			}
+			size += n
+		}
+		return append(dst, ' '), size
+	}
+
+	if !d.keepAccents {
+		for i+size < len(input) {
+			next, n := utf8.DecodeRuneInString(input[i+size:])
+			if !unicode.Is(unicode.Mn, next) {
+				break
+			}
+			size += n
+		}
+		if unicode.Is(unicode.Mn, r) {
+			return dst, size
+		}
+		if base, ok := accentFolds()[r]; ok {
+			for _, b := range base {
+				dst = d.foldCase(dst, b)
+			}
+			return dst, size
+		}
+	}
+	return d.foldCase(dst, r), size
+}
+
+func (d *DictionaryDetector) foldCase(dst []byte, r rune) []byte {
+	if !d.caseSensitive {
+		r = unicode.ToLower(r)
+	}
+	return utf8.AppendRune(dst, r)
+}
+
+// fold returns the folded form of a term.
+func (d *DictionaryDetector) fold(term string) string {
+	term = strings.TrimSpace(term)
+	var out []byte
+	var buf [2 * utf8.UTFMax]byte
+	for i := 0; i < len(term); {
+		folded, size := d.foldNext(buf[:0], term, i)
+		out = append(out, folded...)
+		i += size
+	}
+	return string(out)
+}
+
+// accentFoldPairs lists the Latin letters folded to their base letters,
+// as "letter:base" pairs.
+const accentFoldPairs = "À:A Á:A Â:A Ã:A Ä:A Å:A Æ:AE Ç:C È:E É:E Ê:E Ë:E Ì:I Í:I Î:I Ï:I " +
+	"Ð:D Ñ:N Ò:O Ó:O Ô:O Õ:O Ö:O Ø:O Ù:U Ú:U Û:U Ü:U Ý:Y Þ:TH ß:ss " +
+	"à:a á:a â:a ã:a ä:a å:a æ:ae ç:c è:e é:e ê:e ë:e ì:i í:i î:i ï:i " +
+	"ð:d ñ:n ò:o ó:o ô:o õ:o ö:o ø:o ù:u ú:u û:u ü:u ý:y þ:th ÿ:y " +
+	"Ā:A ā:a Ă:A ă:a Ą:A ą:a Ć:C ć:c Č:C č:c Ď:D ď:d Đ:D đ:d Ē:E ē:e " +
+	"Ė:E ė:e Ę:E ę:e Ě:E ě:e Ğ:G ğ:g Ī:I ī:i Į:I į:i İ:I ı:i Ł:L ł:l " +
+	"Ń:N ń:n Ň:N ň:n Ō:O ō:o Ő:O ő:o Œ:OE œ:oe Ř:R ř:r Ś:S ś:s Ş:S ş:s " +
+	"Š:S š:s Ţ:T ţ:t Ť:T ť:t Ū:U ū:u Ů:U ů:u Ű:U ű:u Ų:U ų:u Ÿ:Y Ź:Z ź:z " +
+	"Ż:Z ż:z Ž:Z ž:z"
+
+var (
+	accentFoldOnce sync.Once
+	accentFoldMap  map[rune]string
+)
+
+func accentFolds() map[rune]string {
+	accentFoldOnce.Do(func() {
+		pairs := strings.Fields(accentFoldPairs)
+		accentFoldMap = make(map[rune]string, len(pairs))
+		for _, p := range pairs {
+			letter, base, _ := strings.Cut(p, ":")
+			r, _ := utf8.DecodeRuneInString(letter)
+			accentFoldMap[r] = base
+		}
+	})
+	return accentFoldMap
+}
+
+// dictAutomaton is an immutable Aho–Corasick automaton over folded bytes.
+type dictAutomaton struct {
+	states   []dictState
+	root     [256]int32 // dense transitions of the root state
+	entries  []dictEntry
+	maxDepth int
+}
+
+type dictState struct {
+	edges []dictEdge // sorted by byte
+	fail  int32
+	dict  int32 // nearest state on the fail chain with an output, or 0
+	out   int32 // entry ending here, or -1
+	depth int32
+}
+
+type dictEdge struct {
+	b    byte
+	next int32
+}
+
+type dictEntry struct {
+	typ  PIIType
+	meta map[string]string
+}
+
+func (d *DictionaryDetector) build(entries []DictionaryEntry) *dictAutomaton {
+	a := &dictAutomaton{states: []dictState{{out: -1}}}
+
+	for _, e := range entries {
+		key := d.fold(e.Term)
+		if key == "" {
+			continue
+		}
+		s := int32(0)
+		for i := 0; i < len(key); i++ {
+			next := int32(-1)
+			for _, edge := range a.states[s].edges { // not sorted yet
+				if edge.b == key[i] {
+					next = edge.next
+					break
+				}
+			}
+			if next < 0 {
+				next = int32(len(a.states))
+				a.states = append(a.states, dictState{out: -1, depth: a.states[s].depth + 1})
+				a.states[s].edges = append(a.states[s].edges, dictEdge{key[i], next})
+			}
+			s = next
+		}
+		if a.states[s].out >= 0 {
+			continue // first entry wins
+		}
+		typ := e.Type
+		if typ == "" {
+			typ = d.typ
+		}
+		a.states[s].out = int32(len(a.entries))
+		a.entries = append(a.entries, dictEntry{typ: typ, meta: map[string]string{"term": e.Term}})
+		if len(key) > a.maxDepth {
+			a.maxDepth = len(key)
+		}
+	}
+
+	for i := range a.states {
+		edges := a.states[i].edges
+		sort.Slice(edges, func(x, y int) bool { return edges[x].b < edges[y].b })
+	}
+	for _, e := range a.states[0].edges {
+		a.root[e.b] = e.next
+	}
+
+	// Breadth-first, so fail links always point to states already linked
+	queue := make([]int32, 0, len(a.states))
+	for _, e := range a.states[0].edges {
+		queue = append(queue, e.next)
+	}
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+		for _, e := range a.states[s].edges {
+			t := e.next
+			if s != 0 {
+				a.states[t].fail = a.step(a.states[s].fail, e.b)
+			}
+			f := a.states[t].fail
+			if a.states[f].out >= 0 {
+				a.states[t].dict = f
+			} else {
+				a.states[t].dict = a.states[f].dict
+			}
+			queue = append(queue, t)
+		}
+	}
+	return a
+}
+
+func (a *dictAutomaton) child(s int32, b byte) int32 {
+	edges := a.states[s].edges
+	i := sort.Search(len(edges), func(i int) bool { return edges[i].b >= b })
+	if i < len(edges) && edges[i].b == b {
+		return edges[i].next
+	}
+	return -1
+}
+
+// step follows the transition on b, falling back along fail links.
+func (a *dictAutomaton) step(s int32, b byte) int32 {
+	for s != 0 {
+		if next := a.child(s, b); next >= 0 {
+			return next
+		}
+		s = a.states[s].fail
+	}
+	return a.root[b]
+}
@@ -0,0 +1,172 @@
+package detectors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestDictionaryDetector(t *testing.T) {
+	d := NewDictionary("customers", NewPIIType("customer"), []DictionaryEntry{
+		{Term: "Acme Corp"},
+		{Term: "Acme"},
+		{Term: "João Silva", Type: NewPIIType("vip")},
+		{Term: "Falcon", Type: NewPIIType("project")},
+		{Term: "Straße AG"},
+		{Term: "@globex"},
+	})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Valid Cases
+		{"Exact", "Invoice for Acme Corp attached", []string{"Acme Corp"}},
+		{"Case Folding", "invoice for ACME CORP", []string{"ACME CORP"}},
+		{"Longest Wins", "acme corp and acme", []string{"acme corp", "acme"}},
+		{"Accent Folding", "call joao silva today", []string{"joao silva"}},
+		{"Accented Input", "call JOÃO SILVA", []string{"JOÃO SILVA"}},
+		{"Combining Marks", "call João Silva", []string{"João Silva"}},
+		{"Whitespace Runs", "Acme\n   Corp", []string{"Acme\n   Corp"}},
+		{"Expanding Fold", "contract with Strasse AG", []string{"Strasse AG"}},
+		{"Punctuation Term", "ping@globex today", []string{"@globex"}},
+		{"Multiple Types", "Falcon for João Silva", []string{"Falcon", "João Silva"}},
+		{"Edges", "Acme", []string{"Acme"}},
+
+		// Invalid Cases
+		{"Inside Word", "Acmeville and Falconry", nil},
+		{"Prefix Word", "NotAcme", nil},
+		{"Digit Boundary", "Acme2 Falcon9", nil},
+		{"Missing Word", "Joao Souza", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Value)
+				if tt.input[m.StartIndex:m.EndIndex] != m.Value {
+					t.Errorf("span [%d:%d] does not match value %q", m.StartIndex, m.EndIndex, m.Value)
+				}
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+				}
+			}
+		})
+	}
+
+	m := d.Scan("Falcon for joao silva")
+	if len(m) != 2 || m[0].Type != "PROJECT" || m[1].Type != "VIP" || m[1].Metadata["term"] != "João Silva" {
+		t.Errorf("unexpected types or metadata: %+v", m)
+	}
+	if m := d.Scan("Acme"); m[0].Type != "CUSTOMER" {
+		t.Errorf("expected the detector type, got %s", m[0].Type)
+	}
+}
+
+func TestDictionaryDetector_Options(t *testing.T) {
+	entries := DictionaryTerms("João", "Globex")
+
+	strict := NewDictionary("d", "X", entries, DictionaryCaseSensitive(), DictionaryKeepAccents())
+	if m := strict.Scan("joão Joao GLOBEX"); len(m) != 0 {
+		t.Errorf("expected no matches, got %v", m)
+	}
+	if m := strict.Scan("João Globex"); len(m) != 2 {
+		t.Errorf("expected 2 matches, got %v", m)
+	}
+
+	partial := NewDictionary("d", "X", entries, DictionaryPartialWords(), DictionaryScore(0.5))
+	m := partial.Scan("MegaGlobexCorp")
+	if len(m) != 1 || m[0].Value != "Globex" || m[0].Score != 0.5 {
+		t.Errorf("unexpected partial match: %v", m)
+	}
+}
+
+func TestDictionaryDetector_MetadataOwned(t *testing.T) {
+	d := NewDictionary("vip", TypeName, DictionaryTerms("Ana Souza"))
+
+	first := d.Scan("Ana Souza called")
+	first[0].Metadata["term"] = "changed"
+	if got := d.Scan("Ana Souza called")[0].Metadata["term"]; got != "Ana Souza" {
+		t.Errorf("metadata leaked between scans: term %q", got)
+	}
+}
+
+func TestDictionaryDetector_Update(t *testing.T) {
+	d := NewDictionary("projects", "PROJECT", DictionaryTerms("Falcon", "falcon", ""))
+	if d.Len() != 1 {
+		t.Errorf("expected duplicates and empty terms to be dropped, got %d terms", d.Len())
+	}
+
+	d.Update(DictionaryTerms("Osprey"))
+	if m := d.Scan("Falcon and Osprey"); len(m) != 1 || m[0].Value != "Osprey" {
+		t.Errorf("update not applied: %v", m)
+	}
+
+	d.Update(nil)
+	if m := d.Scan("Osprey"); len(m) != 0 {
+		t.Errorf("expected an empty dictionary, got %v", m)
+	}
+}
+
+func TestDictionaryDetector_Concurrency(t *testing.T) {
+	d := NewDictionary("projects", "PROJECT", DictionaryTerms("Falcon"))
+	concurrency := 100
+	var wg sync.WaitGroup
+
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func(i int) {
+			defer wg.Done()
+			if i%10 == 0 {
+				d.Update(DictionaryTerms("Falcon", fmt.Sprintf("Term%d", i)))
+				return
+			}
+			if m := d.Scan("Project Falcon status"); len(m) != 1 {
+				t.Errorf("Concurrent scan failed to find match")
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// Run with: go test -fuzz=FuzzDictionary -fuzztime=10s
+func FuzzDictionaryDetector(f *testing.F) {
+	d := NewDictionary("d", "X", DictionaryTerms("Acme Corp", "João", "Straße", "a", "ss"))
+
+	f.Add("Acme   Corp joao")
+	f.Add("João Strasse ß")
+	f.Add("\xff\xfe a")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		for _, m := range d.Scan(orig) {
+			if m.StartIndex < 0 || m.EndIndex > len(orig) || m.StartIndex >= m.EndIndex {
+				t.Fatalf("bad span %d:%d", m.StartIndex, m.EndIndex)
+			}
+		}
+	})
+}
+
+func BenchmarkDictionaryDetector_5000Terms(b *testing.B) {
+	terms := make([]string, 5000)
+	for i := range terms {
+		terms[i] = fmt.Sprintf("Customer %d Ltda", i)
+	}
+	d := NewDictionary("customers", "CUSTOMER", DictionaryTerms(terms...))
+	payload := strings.Repeat("Invoice for customer 4321 ltda sent to João, total R$ 1.234,56. ", 20)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.Scan(payload)
+	}
+}
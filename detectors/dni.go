@@ -0,0 +1,86 @@
+package detectors
+
+type DNIDetector struct{}
+
+func (d *DNIDetector) Name() string {
+	return "es_dni"
+}
+
+func (d *DNIDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *DNIDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *DNIDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [8]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '.') {
+			continue
+		}
+
+		count, j, ok := readGroupedDigits(input, i, digits[:])
+		if !ok {
+			continue
+		}
+		if count != 8 {
+			i = j
+			continue
+		}
+
+		if end, ok := matchDNILetter(input, j); ok && input[end-1] == dniControlLetter(digits[:]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeDNI,
+				Score:      1.0,
+			})
+			i = end - 1
+			continue
+		}
+		i = j
+	}
+
+	return results
+}
+
+// NewDNIDetector detects Spanish DNI numbers (12345678Z, 12.345.678-Z).
+func NewDNIDetector() Detector {
+	return &DNIDetector{}
+}
+
+// matchDNILetter reads the control letter at j, optionally preceded by '-'
+// or ' ', and returns the index right after it.
+func matchDNILetter(s string, j int) (int, bool) {
+	if j < len(s) && (s[j] == '-' || s[j] == ' ') {
+		j++
+	}
+	if j >= len(s) || !isUpperLetter(s[j]) {
+		return 0, false
+	}
+	j++
+	if j < len(s) && isAlnumChar(s[j]) {
+		return 0, false
+	}
+	return j, true
+}
+
+const dniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// dniControlLetter returns the letter for number mod 23, shared by DNI and NIE.
+func dniControlLetter(digits []byte) byte {
+	rem := 0
+	for _, c := range digits {
+		rem = (rem*10 + int(c-'0')) % 23
+	}
+	return dniLetters[rem]
+}
@@ -0,0 +1,51 @@
+package detectors
+
+import "testing"
+
+func TestDNIDetector(t *testing.T) {
+	d := NewDNIDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Plain", "DNI 12345678Z registrado.", 1},
+		{"Valid Dashed", "DNI: 12345678-Z", 1},
+		{"Valid Dotted", "DNI 12.345.678-Z", 1},
+
+		// Near Misses
+		{"Wrong Letter", "DNI 12345678A", 0},
+		{"Lowercase Letter", "DNI 12345678z", 0},
+		{"Seven Digits", "DNI 1234567Z", 0},
+		{"Nine Digits", "DNI 123456789Z", 0},
+		{"Letter Followed By Text", "DNI 12345678ZX", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "DNI 12345678Z 🚀", 1},
+		{"Sentence End", "Mi DNI es 12345678Z.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzDNI -fuzztime=10s
+func FuzzDNIDetector(f *testing.F) {
+	d := NewDNIDetector()
+
+	f.Add("12345678Z")
+	f.Add("12.345.678-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
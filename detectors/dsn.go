@@ -0,0 +1,303 @@
+package detectors
+
+// DSNDetector finds database connection strings and reports their password,
+// leaving the rest readable. It understands:
+//
+//   - URI DSNs: postgres://app:pw@db:5432/x, mongodb+srv://..., redis://:pw@host
+//     (also behind a "jdbc:" prefix)
+//   - Oracle thin JDBC URLs: jdbc:oracle:thin:app/pw@host:1521/ORCL
+//   - key=value strings: ADO.NET "Server=db;User Id=sa;Password=pw;",
+//     JDBC "...;password=pw" or "?user=app&password=pw" and libpq
+//     "host=db user=app password=pw"
+//
+// Password keys are masked wherever they appear. User and host keys are only
+// considered on lines that look like a connection string (two or more
+// connection keys), since "user=" or "host=" alone is too common.
+type DSNDetector struct {
+	// User also reports the user name (DB_USER).
+	User bool
+	// Host also reports the host name(s) (DB_HOST).
+	Host bool
+}
+
+var dsnSchemes = []string{
+	"postgres", "postgresql", "mysql", "mariadb", "mongodb", "mongodb+srv",
+	"redis", "rediss", "amqp", "amqps", "mssql", "sqlserver", "oracle",
+	"clickhouse", "cockroachdb", "cassandra", "couchdb", "neo4j", "memcached",
+}
+
+type dsnKeyKind uint8
+
+const (
+	dsnKeyOther dsnKeyKind = iota
+	dsnKeyPassword
+	dsnKeyUser
+	dsnKeyHost
+)
+
+var dsnKeys = []struct {
+	name string
+	kind dsnKeyKind
+}{
+	{"password", dsnKeyPassword}, {"passwd", dsnKeyPassword}, {"pwd", dsnKeyPassword},
+	{"user id", dsnKeyUser}, {"userid", dsnKeyUser}, {"uid", dsnKeyUser},
+	{"username", dsnKeyUser}, {"user", dsnKeyUser},
+	{"data source", dsnKeyHost}, {"server", dsnKeyHost}, {"hostname", dsnKeyHost},
+	{"host", dsnKeyHost}, {"address", dsnKeyHost}, {"addr", dsnKeyHost},
+	{"database", dsnKeyOther}, {"dbname", dsnKeyOther}, {"initial catalog", dsnKeyOther},
+	{"port", dsnKeyOther},
+}
+
+func (d *DSNDetector) Name() string {
+	return "dsn"
+}
+
+func (d *DSNDetector) Scan(input string) []Match {
+	var results []Match
+
+	for i := 0; i+3 <= len(input); i++ {
+		switch {
+		case input[i] == ':' && input[i+1] == '/' && input[i+2] == '/':
+			if start, ok := dsnSchemeStart(input, i); ok && start < i {
+				results = d.scanURI(results, input, i+3)
+			}
+		case input[i] == 't' && hasPrefixFold(input[i:], "thin:") && i >= 7 && hasPrefixFold(input[i-7:], "oracle:"):
+			results = d.scanOracleThin(results, input, i+5)
+		}
+	}
+
+	// key=value pairs, one line at a time
+	for start := 0; start < len(input); {
+		end := start
+		for end < len(input) && input[end] != '\n' {
+			end++
+		}
+		results = d.scanKeyValues(results, input, start, end)
+		start = end + 1
+	}
+
+	return results
+}
+
+func NewDSNDetector() Detector {
+	return &DSNDetector{}
+}
+
+func dsnSchemeStart(input string, i int) (int, bool) {
+	start := i
+	for start > 0 && (isAlnumChar(input[start-1]) || input[start-1] == '+') {
+		start--
+	}
+	for _, scheme := range dsnSchemes {
+		if i-start == len(scheme) && hasPrefixFold(input[start:], scheme) {
+			return start, true
+		}
+	}
+	return 0, false
+}
+
+func isDSNStop(c byte) bool {
+	return c <= ' ' || c == '"' || c == '\'' || c == '`' || c == '<' || c == '>'
+}
+
+// scanURI handles the authority of a URI DSN starting at i:
+// [user[:password]@]host[:port][,host...]
+func (d *DSNDetector) scanURI(results []Match, input string, i int) []Match {
+	end := i
+	for end < len(input) && !isDSNStop(input[end]) && input[end] != '/' && input[end] != '?' && input[end] != ';' {
+		end++
+	}
+
+	at := -1
+	for k := end - 1; k >= i; k-- {
+		if input[k] == '@' {
+			at = k
+			break
+		}
+	}
+
+	hostStart := i
+	if at >= 0 {
+		colon := at
+		for k := i; k < at; k++ {
+			if input[k] == ':' {
+				colon = k
+				break
+			}
+		}
+		results = d.appendUser(results, input, i, colon)
+		if colon < at {
+			results = appendDSNMatch(results, input, colon+1, at, TypeDBPassword, 1.0)
+		}
+		hostStart = at + 1
+	}
+
+	return d.appendHost(results, input, hostStart, trimDSNPort(input, hostStart, end))
+}
+
+// scanOracleThin handles "jdbc:oracle:thin:user/password@host...", with i
+// right after "thin:".
+func (d *DSNDetector) scanOracleThin(results []Match, input string, i int) []Match {
+	slash, at := -1, -1
+	for k := i; k < len(input) && !isDSNStop(input[k]); k++ {
+		if input[k] == '/' && slash < 0 {
+			slash = k
+		}
+		if input[k] == '@' {
+			at = k
+			break
+		}
+	}
+	if at < 0 || slash < 0 || slash > at {
+		return results
+	}
+
+	results = d.appendUser(results, input, i, slash)
+	results = appendDSNMatch(results, input, slash+1, at, TypeDBPassword, 1.0)
+
+	hostStart := at + 1
+	for hostStart < len(input) && input[hostStart] == '/' {
+		hostStart++
+	}
+	end := hostStart
+	for end < len(input) && !isDSNStop(input[end]) && input[end] != '/' && input[end] != ':' {
+		end++
+	}
+	return d.appendHost(results, input, hostStart, end)
+}
+
+type dsnPair struct {
+	kind       dsnKeyKind
+	start, end int
+}
+
+// scanKeyValues handles key=value pairs within input[start:end].
+func (d *DSNDetector) scanKeyValues(results []Match, input string, start, end int) []Match {
+	var pairs [16]dsnPair
+	n, keys := 0, 0
+
+	for i := start; i < end; i++ {
+		if i > start && !isDSNKeyBoundary(input[i-1]) {
+			continue
+		}
+		kind, valStart, valEnd, ok := dsnKeyValue(input, i, end)
+		if !ok {
+			continue
+		}
+		keys++
+		if kind == dsnKeyPassword {
+			results = appendDSNMatch(results, input, valStart, valEnd, TypeDBPassword, 1.0)
+		} else if kind != dsnKeyOther && n < len(pairs) {
+			pairs[n] = dsnPair{kind, valStart, valEnd}
+			n++
+		}
+		i = valEnd
+	}
+
+	if keys < 2 {
+		return results
+	}
+	for _, p := range pairs[:n] {
+		switch {
+		case p.kind == dsnKeyUser && d.User:
+			results = appendDSNMatch(results, input, p.start, p.end, TypeDBUser, 0.9)
+		case p.kind == dsnKeyHost && d.Host:
+			results = appendDSNMatch(results, input, p.start, trimDSNPort(input, p.start, p.end), TypeDBHost, 0.9)
+		}
+	}
+	return results
+}
+
+func isDSNKeyBoundary(c byte) bool {
+	return c == ';' || c == '?' || c == '&' || c == ' ' || c == '\t' || c == '"' || c == '\'' || c == '('
+}
+
+// dsnKeyValue matches a known key at i followed by '=' and returns the value
+// span, without surrounding quotes.
+func dsnKeyValue(input string, i, end int) (dsnKeyKind, int, int, bool) {
+	for _, key := range dsnKeys {
+		if i+len(key.name) > end || !hasPrefixFold(input[i:end], key.name) {
+			continue
+		}
+		j := i + len(key.name)
+		for j < end && input[j] == ' ' {
+			j++
+		}
+		if j >= end || input[j] != '=' {
+			continue
+		}
+		j++
+		for j < end && input[j] == ' ' {
+			j++
+		}
+		if j >= end {
+			return 0, 0, 0, false
+		}
+
+		if q := input[j]; q == '\'' || q == '"' {
+			k := j + 1
+			for k < end && input[k] != q {
+				k++
+			}
+			if k >= end || k == j+1 {
+				return 0, 0, 0, false
+			}
+			return key.kind, j + 1, k, true
+		}
+
+		k := j
+		for k < end && input[k] != ';' && input[k] != '&' && !isDSNStop(input[k]) {
+			k++
+		}
+		if k == j {
+			return 0, 0, 0, false
+		}
+		return key.kind, j, k, true
+	}
+	return 0, 0, 0, false
+}
+
+// trimDSNPort drops a trailing ":port" from a single host; host lists
+// (h1:27017,h2:27017) are kept whole.
+func trimDSNPort(input string, start, end int) int {
+	for k := start; k < end; k++ {
+		if input[k] == ',' {
+			return end
+		}
+	}
+	k := end
+	for k > start && isDigitChar(input[k-1]) {
+		k--
+	}
+	if k < end && k > start+1 && input[k-1] == ':' {
+		return k - 1
+	}
+	return end
+}
+
+func (d *DSNDetector) appendUser(results []Match, input string, start, end int) []Match {
+	if !d.User {
+		return results
+	}
+	return appendDSNMatch(results, input, start, end, TypeDBUser, 0.9)
+}
+
+func (d *DSNDetector) appendHost(results []Match, input string, start, end int) []Match {
+	if !d.Host {
+		return results
+	}
+	return appendDSNMatch(results, input, start, end, TypeDBHost, 0.9)
+}
+
+func appendDSNMatch(results []Match, input string, start, end int, typ PIIType, score float32) []Match {
+	if start >= end {
+		return results
+	}
+	return append(results, Match{
+		StartIndex: start,
+		EndIndex:   end,
+		Value:      input[start:end],
+		Type:       typ,
+		Score:      score,
+	})
+}
@@ -1,15 +1,49 @@
 package detectors
 
-type EmailDetector struct{}
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// EmailDetector finds email addresses, including internationalized local
+// parts and domains (RFC 6531): "joão@empresa.com.br", "user@例え.jp". With
+// Obfuscated it also finds
+// addresses written to dodge filters: "john at example dot com",
+// "john[at]example[.]com", "john (arroba) exemplo (ponto) com".
+type EmailDetector struct {
+	Obfuscated bool
+}
 
 func (d *EmailDetector) Name() string {
 	return "email"
 }
 
 func (d *EmailDetector) Scan(input string) []Match {
-	var results []Match
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *EmailDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *EmailDetector) scan(dst []Match, input string) []Match {
+	results := dst
 	for i := 0; i < len(input); i++ {
 		if input[i] != '@' {
+			if d.Obfuscated && i > 0 && isEmailLocalChar(input[i-1]) &&
+				(input[i] == ' ' || indexByte(obfuscatedMarkerOpeners, input[i]) >= 0) {
+				if start, end, score, ok := matchObfuscatedEmail(input, i); ok {
+					results = append(results, Match{
+						StartIndex: start,
+						EndIndex:   end,
+						Value:      input[start:end],
+						Type:       TypeEmail,
+						Score:      score,
+					})
+					i = end - 1
+				}
+			}
 			continue
 		}
 		if start, end, ok := extractEmail(input, i); ok {
@@ -36,11 +70,23 @@ func extractEmail(input string, at int) (int, int, bool) {
 	}
 
 	// Expand left for local part
-	start := at - 1
-	for start >= 0 && isEmailLocalChar(input[start]) {
-		start--
+	start := at
+	last := '@' // the character taken before, to the right
+	for start > 0 {
+		r, size := rune(input[start-1]), 1
+		if r < utf8.RuneSelf {
+			if !isEmailLocalChar(byte(r)) {
+				break
+			}
+		} else if r, size = utf8.DecodeLastRuneInString(input[:start]); !isIntlEmailRune(r) {
+			break
+		}
+		if isScriptSwitch(r, last) {
+			break
+		}
+		start -= size
+		last = r
 	}
-	start++
 
 	// Local part must exist and cannot start/end with '.'
 	if start >= at || input[start] == '.' || input[at-1] == '.' {
@@ -55,8 +101,21 @@ func extractEmail(input string, at int) (int, int, bool) {
 
 	// Expand right for domain part
 	end := at + 1
-	for end < len(input) && isEmailDomainChar(input[end]) {
-		end++
+	last = '@'
+	for end < len(input) {
+		r, size := rune(input[end]), 1
+		if r < utf8.RuneSelf {
+			if !isEmailDomainChar(byte(r)) {
+				break
+			}
+		} else if r, size = utf8.DecodeRuneInString(input[end:]); !isIntlEmailRune(r) {
+			break
+		}
+		if isScriptSwitch(last, r) {
+			break
+		}
+		end += size
+		last = r
 	}
 
 	trimEnd := end
@@ -68,7 +127,7 @@ func extractEmail(input string, at int) (int, int, bool) {
 		return 0, 0, false
 	}
 
-	// TLD must be at least 2 alphabetic chars
+	// TLD must be at least 2 alphabetic chars (or bytes of a non-ASCII label)
 	if tldLen := countTLD(input[at+1 : trimEnd]); tldLen < 2 {
 		return 0, 0, false
 	}
@@ -100,7 +159,7 @@ func countTLD(domain string) int {
 		if c == '.' {
 			return length
 		}
-		if !isLetter(c) {
+		if !isLetter(c) && c < utf8.RuneSelf {
 			return 0
 		}
 		length++
@@ -138,6 +197,29 @@ func isEmailDomainChar(b byte) bool {
 	}
 }
 
+// isIntlEmailRune reports whether a non-ASCII rune may appear in an
+// internationalized local part or domain label: letters, digits and
+// combining marks. Punctuation such as curly quotes stays a boundary.
+func isIntlEmailRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r))
+}
+
+// isScriptSwitch reports whether adjacent characters a and b switch
+// between ASCII and a script written without spaces (Han, Kana, Hangul).
+// There the address ends: "请联系user@example.com谢谢" holds only
+// "user@example.com".
+func isScriptSwitch(a, b rune) bool {
+	return (isASCIIAlnum(a) && isSpacelessScript(b)) || (isSpacelessScript(a) && isASCIIAlnum(b))
+}
+
+func isASCIIAlnum(r rune) bool {
+	return r < utf8.RuneSelf && (isLetter(byte(r)) || isDigitChar(byte(r)))
+}
+
+func isSpacelessScript(r rune) bool {
+	return r >= 0x1100 && unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
 func isLetter(b byte) bool {
 	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
 }
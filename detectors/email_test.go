@@ -47,6 +47,94 @@ func TestEmailDetector(t *testing.T) {
 	}
 }
 
+func TestEmailDetector_International(t *testing.T) {
+	d := NewEmailDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"Accented Local Part", "fale com joão@empresa.com.br hoje", []string{"joão@empresa.com.br"}},
+		{"IDN Domain", "mail user@例え.jp now", []string{"user@例え.jp"}},
+		{"Cyrillic TLD", "пишите info@почта.рф", []string{"info@почта.рф"}},
+		{"Accented Domain", "josé@café.fr", []string{"josé@café.fr"}},
+		{"Curly Quotes", "“john@example.com”", []string{"john@example.com"}},
+		{"CJK Punctuation", "连络：user@example.com。", []string{"user@example.com"}},
+		{"Glued Chinese", "请联系user@example.com谢谢", []string{"user@example.com"}},
+		{"Glued Japanese", "メールはuser@example.comです", []string{"user@example.com"}},
+		{"Glued Korean", "메일user@example.com으로", []string{"user@example.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Value)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestEmailDetector_Obfuscated(t *testing.T) {
+	d := &EmailDetector{Obfuscated: true}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Valid Cases
+		{"Words", "write to john at example dot com today", []string{"john at example dot com"}},
+		{"Brackets", "john[at]example[.]com", []string{"john[at]example[.]com"}},
+		{"Parens With Spaces", "mail john (at) example (dot) com", []string{"john (at) example (dot) com"}},
+		{"Uppercase", "JOHN AT EXAMPLE DOT COM", []string{"JOHN AT EXAMPLE DOT COM"}},
+		{"Bracketed At Literal Dot", "ana.silva[at]mail.com.br", []string{"ana.silva[at]mail.com.br"}},
+		{"Portuguese", "fale com maria arroba empresa ponto com ponto br", []string{"maria arroba empresa ponto com ponto br"}},
+		{"Portuguese Brackets", "maria(arroba)empresa(ponto)com", []string{"maria(arroba)empresa(ponto)com"}},
+		{"Regular Email Still Found", "john@example.com or ana at x dot io", []string{"john@example.com", "ana at x dot io"}},
+
+		// False Positives in prose
+		{"Meet At Home", "let's meet at home tonight", nil},
+		{"Look At Site", "take a look at google.com first", nil},
+		{"At Time", "arrived at 10.30 sharp", nil},
+		{"No Dot", "john at example", nil},
+		{"Dot Without TLD", "stay at home dot", nil},
+		{"Numeric TLD", "john at example dot 123", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Value)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+				}
+			}
+		})
+	}
+
+	if m := NewEmailDetector().Scan("john at example dot com"); len(m) != 0 {
+		t.Errorf("obfuscated forms must be opt-in, got %v", m)
+	}
+}
+
 // 2. Concurrency Test (Thread-Safety)
 func TestEmailDetector_Concurrency(t *testing.T) {
 	d := NewEmailDetector()
@@ -71,15 +159,18 @@ func TestEmailDetector_Concurrency(t *testing.T) {
 // Run with: go test -fuzz=FuzzEmail -fuzztime=10s
 func FuzzEmailDetector(f *testing.F) {
 	d := NewEmailDetector()
+	obfuscated := &EmailDetector{Obfuscated: true}
 
 	// Seed corpus
 	f.Add("test@example.com")
 	f.Add("user+tag@sub.domain.co.uk")
 	f.Add("not an email")
+	f.Add("john [at] example (dot) com")
 
 	f.Fuzz(func(t *testing.T, orig string) {
 		// MUST NOT PANIC
 		_ = d.Scan(orig)
+		_ = obfuscated.Scan(orig)
 	})
 }
 
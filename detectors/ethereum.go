@@ -0,0 +1,104 @@
+package detectors
+
+// EthereumDetector finds 0x-prefixed 40-hex-digit addresses. Mixed-case
+// addresses must carry a valid EIP-55 checksum; all-lowercase or all-uppercase
+// addresses carry none and are reported with a lower score.
+type EthereumDetector struct{}
+
+func (d *EthereumDetector) Name() string {
+	return "crypto_ethereum"
+}
+
+func (d *EthereumDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *EthereumDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *EthereumDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+42 <= len(input); i++ {
+		if input[i] != '0' || (input[i+1] != 'x' && input[i+1] != 'X') {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		end := i + 42
+		if end < len(input) && isAlnumChar(input[end]) {
+			continue
+		}
+
+		if score, ok := ethereumAddressScore(input[i+2 : end]); ok {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeEthereumAddress,
+				Score:      score,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+func NewEthereumDetector() Detector {
+	return &EthereumDetector{}
+}
+
+// ethereumAddressScore validates the 40 hex digits of an address.
+func ethereumAddressScore(hex string) (float32, bool) {
+	lower, upper := false, false
+	for k := 0; k < len(hex); k++ {
+		c := hex[k]
+		switch {
+		case isDigitChar(c):
+		case c >= 'a' && c <= 'f':
+			lower = true
+		case c >= 'A' && c <= 'F':
+			upper = true
+		default:
+			return 0, false
+		}
+	}
+	if !lower || !upper {
+		return 0.8, true
+	}
+	return 1.0, isValidEIP55(hex)
+}
+
+// isValidEIP55 checks that each letter is uppercase exactly when the matching
+// nibble of keccak256(lowercase address) is >= 8.
+func isValidEIP55(hex string) bool {
+	var lowered [40]byte
+	for k := 0; k < 40; k++ {
+		c := hex[k]
+		if c >= 'A' && c <= 'F' {
+			c += 'a' - 'A'
+		}
+		lowered[k] = c
+	}
+	hash := keccak256(string(lowered[:]))
+
+	for k := 0; k < 40; k++ {
+		c := hex[k]
+		if isDigitChar(c) {
+			continue
+		}
+		nibble := hash[k/2]
+		if k%2 == 0 {
+			nibble >>= 4
+		}
+		if (nibble&0x0f >= 8) != (c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
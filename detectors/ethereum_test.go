@@ -0,0 +1,64 @@
+package detectors
+
+import "testing"
+
+func TestEthereumDetector(t *testing.T) {
+	d := NewEthereumDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"EIP-55 Checksum", "Send to 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", 1},
+		{"EIP-55 Checksum 2", "wallet 0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359.", 1},
+		{"All Lowercase", "to: 0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", 1},
+		{"All Uppercase", "to: 0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", 1},
+
+		// Invalid Cases
+		{"Broken Checksum", "Send to 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", 0},
+		{"Too Short", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", 0},
+		{"Too Long (Tx Hash)", "tx 0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b", 0},
+		{"Non Hex", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeZ", 0},
+
+		// Noise & Boundary
+		{"Glued Prefix", "a0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", 0},
+		{"Unicode Noise", "ETH 🚀 0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEthereumDetector_Score(t *testing.T) {
+	d := NewEthereumDetector()
+
+	checked := d.Scan("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	plain := d.Scan("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	if len(checked) != 1 || len(plain) != 1 {
+		t.Fatalf("expected one match each, got %d and %d", len(checked), len(plain))
+	}
+	if checked[0].Score <= plain[0].Score {
+		t.Errorf("checksummed score %v should exceed unchecked score %v", checked[0].Score, plain[0].Score)
+	}
+}
+
+// Run with: go test -fuzz=FuzzEthereum -fuzztime=10s
+func FuzzEthereumDetector(f *testing.F) {
+	d := NewEthereumDetector()
+
+	f.Add("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	f.Add("0x")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -1,5 +1,10 @@
 package detectors
 
+import (
+	"maps"
+	"unsafe"
+)
+
 func isDigitChar(b byte) bool {
 	return b >= '0' && b <= '9'
 }
@@ -17,3 +22,156 @@ func isCNPJSeparator(b byte) bool {
 func isCPFSeparator(b byte) bool {
 	return b == '.' || b == '-' || b == ' '
 }
+
+func isUpperLetter(b byte) bool {
+	return b >= 'A' && b <= 'Z'
+}
+
+func isAlnumChar(b byte) bool {
+	return isDigitChar(b) || isLetter(b)
+}
+
+// readGroupedDigits reads a digit run starting at i that may use '.' as a
+// thousands separator (12.345.678). It copies the digits into buf and returns
+// how many were read and the index right after the run. ok is false when the
+// run does not fit in buf or the dots are not placed every three digits.
+func readGroupedDigits(input string, i int, buf []byte) (count, end int, ok bool) {
+	lastDot := -1
+	j := i
+	for j < len(input) {
+		c := input[j]
+		if isDigitChar(c) {
+			if count == len(buf) {
+				return 0, 0, false
+			}
+			buf[count] = c
+			count++
+			j++
+			continue
+		}
+		if c == '.' && j+1 < len(input) && isDigitChar(input[j+1]) {
+			if lastDot == -1 {
+				if count > 3 {
+					return 0, 0, false
+				}
+			} else if count-lastDot != 3 {
+				return 0, 0, false
+			}
+			lastDot = count
+			j++
+			continue
+		}
+		break
+	}
+	if lastDot != -1 && count-lastDot != 3 {
+		return 0, 0, false
+	}
+	return count, j, true
+}
+
+// isValidYYMMDD validates a two-digit-year date such as the one embedded in
+// Mexican RFC/CURP codes. Without the century, Feb 29 is accepted whenever the
+// two-digit year is divisible by 4.
+func isValidYYMMDD(b []byte) bool {
+	for i := 0; i < 6; i++ {
+		if !isDigitChar(b[i]) {
+			return false
+		}
+	}
+	year := int(b[0]-'0')*10 + int(b[1]-'0')
+	month := int(b[2]-'0')*10 + int(b[3]-'0')
+	day := int(b[4]-'0')*10 + int(b[5]-'0')
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	return day <= daysInMonth(year, month)
+}
+
+func daysInMonth(year, month int) int {
+	switch month {
+	case 2:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0 || year < 100) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	default:
+		return 31
+	}
+}
+
+// readFixedDigits reads exactly len(buf) digits starting at i, skipping the
+// separators that validSep accepts for the current digit count. It returns the
+// index right after the last digit; ok is false when the run is shorter,
+// contains a misplaced separator or continues with another digit.
+func readFixedDigits(input string, i int, buf []byte, validSep func(c byte, count int) bool) (end int, ok bool) {
+	count := 0
+	j := i
+	for j < len(input) && count < len(buf) {
+		c := input[j]
+		switch {
+		case isDigitChar(c):
+			buf[count] = c
+			count++
+		case count > 0 && validSep(c, count):
+		default:
+			return 0, false
+		}
+		j++
+	}
+	if count < len(buf) || (j < len(input) && isDigitChar(input[j])) {
+		return 0, false
+	}
+	return j, true
+}
+
+// keywordBefore returns the first keyword found in the window bytes that
+// precede pos, or "" when none is present. Keywords must be lowercase and
+// are matched ASCII case-insensitively as whole words, so "sol" does not
+// match "solution".
+func keywordBefore(input string, pos, window int, keywords []string) string {
+	from := pos - window
+	if from < 0 {
+		from = 0
+	}
+	for k := from; k < pos; k++ {
+		if k > 0 && isLetter(input[k-1]) {
+			continue
+		}
+		for _, kw := range keywords {
+			end := k + len(kw)
+			if end <= pos && hasPrefixFold(input[k:], kw) && !isWordByte(input, end) {
+				return kw
+			}
+		}
+	}
+	return ""
+}
+
+// isWordByte reports whether input[i] continues a word: an ASCII letter or
+// part of a non-ASCII character.
+func isWordByte(input string, i int) bool {
+	return i < len(input) && (isLetter(input[i]) || input[i] >= 0x80)
+}
+
+// bytesToString returns a string sharing b's memory, for ScanAppend. The
+// string must not outlive changes to b.
+func bytesToString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
+// ownMetadata gives each match its own copy of its metadata. Detectors
+// share one read-only map per brand or kind on the allocation-free
+// ScanAppend path; Scan results belong to the caller.
+func ownMetadata(matches []Match) []Match {
+	for i := range matches {
+		if matches[i].Metadata != nil {
+			matches[i].Metadata = maps.Clone(matches[i].Metadata)
+		}
+	}
+	return matches
+}
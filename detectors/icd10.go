@@ -0,0 +1,89 @@
+package detectors
+
+// ICD10Detector finds ICD-10 diagnosis codes (E11.9, F32.A, J45) in patient
+// context. Short codes look like many other tokens (B12, A4), so:
+//
+//   - codes with a subcategory (E11.9) need a patient or diagnosis keyword
+//     ("patient", "paciente", "diagnosis", "dx") within healthContextWindow
+//   - bare 3-character codes need a diagnosis keyword ("dx", "ICD", "CID",
+//     "diagnosis") within the same window
+type ICD10Detector struct{}
+
+func (d *ICD10Detector) Name() string {
+	return "icd10"
+}
+
+var (
+	icd10DiagnosisKeywords = []string{"icd", "cid-10", "cid 10", "cid:", "dx", "diagnosis", "diagnoses", "diagnosed", "diagnostic", "diagnóstico", "diagnostico"}
+	icd10PatientKeywords   = append([]string{"patient", "paciente", "condition", "condição", "hipótese", "hipotese"}, icd10DiagnosisKeywords...)
+)
+
+// healthContextWindow is how far before a code the patient context may be.
+const healthContextWindow = 64
+
+func (d *ICD10Detector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *ICD10Detector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *ICD10Detector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+3 <= len(input); i++ {
+		if !isUpperLetter(input[i]) || (i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '.')) {
+			continue
+		}
+
+		end, sub, ok := readICD10(input, i)
+		if !ok {
+			continue
+		}
+		keywords := icd10DiagnosisKeywords
+		if sub {
+			keywords = icd10PatientKeywords
+		}
+		if keywordBefore(input, i, healthContextWindow, keywords) == "" {
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeICD10,
+			Score:      0.8,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewICD10Detector() Detector {
+	return &ICD10Detector{}
+}
+
+// readICD10 reads a category (letter other than U, digit, digit or letter)
+// and an optional ".X[XXX]" subcategory.
+func readICD10(input string, i int) (end int, sub, ok bool) {
+	if input[i] == 'U' || !isDigitChar(input[i+1]) ||
+		!(isDigitChar(input[i+2]) || isUpperLetter(input[i+2])) {
+		return 0, false, false
+	}
+	end = i + 3
+	if end+1 < len(input) && input[end] == '.' && (isDigitChar(input[end+1]) || isUpperLetter(input[end+1])) {
+		k := end + 1
+		for k < len(input) && k-end <= 4 && (isDigitChar(input[k]) || isUpperLetter(input[k])) {
+			k++
+		}
+		end, sub = k, true
+	}
+	if end < len(input) && isAlnumChar(input[end]) {
+		return 0, false, false
+	}
+	return end, sub, true
+}
@@ -0,0 +1,61 @@
+package detectors
+
+import "testing"
+
+func TestICD10Detector(t *testing.T) {
+	d := NewICD10Detector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Valid Cases
+		{"Diagnosis", "Diagnosis: E11.9 type 2 diabetes", []string{"E11.9"}},
+		{"Diagnosed", "diagnosed with E11.9 in 2019", []string{"E11.9"}},
+		{"Patient Context", "Patient presents with J45.909", []string{"J45.909"}},
+		{"Letter Subcategory", "dx F32.A", []string{"F32.A"}},
+		{"Bare Category With Dx", "Dx: I10, E78.5", []string{"I10", "E78.5"}},
+		{"Portuguese", "CID-10: F41.1 (paciente ansioso)", []string{"F41.1"}},
+
+		// Context
+		{"No Context", "Seat E11.9 on the plan", nil},
+		{"Bare Category Patient Only", "patient takes B12 daily", nil},
+
+		// Invalid Cases
+		{"Reserved U", "Diagnosis: U07.1", nil},
+		{"Lowercase", "Diagnosis: e11.9", nil},
+		{"Glued", "Diagnosis: XE11.9", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Value)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+				}
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzICD10 -fuzztime=10s
+func FuzzICD10Detector(f *testing.F) {
+	d := NewICD10Detector()
+
+	f.Add("Diagnosis: E11.9")
+	f.Add("dx A0")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,105 @@
+package detectors
+
+type IMEIDetector struct{}
+
+func (d *IMEIDetector) Name() string {
+	return "global_imei"
+}
+
+// imeiKeywords must precede a 16-digit IMEISV, which has no check digit.
+var imeiKeywords = []string{"imeisv", "imei"}
+
+// imeiKeywordWindow is how far before a number the keyword may appear.
+const imeiKeywordWindow = 24
+
+func (d *IMEIDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *IMEIDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *IMEIDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [16]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		start := i
+		count := 0
+		end := i
+		j := i
+		for j < len(input) && count < 16 {
+			c := input[j]
+			switch {
+			case isDigitChar(c):
+				digits[count] = c
+				count++
+				end = j + 1
+			case (c == '-' || c == ' ' || c == '/') && validIMEISeparator(count):
+			default:
+				goto evaluate
+			}
+			j++
+		}
+
+	evaluate:
+		if end < len(input) && isDigitChar(input[end]) {
+			continue
+		}
+		if count < 15 || !isIMEIReportingBody(digits[0], digits[1]) {
+			continue
+		}
+
+		switch {
+		case count == 15 && isValidLuhnBytes(digits[:15]):
+			// IMEI: TAC + serial + Luhn check digit
+		case count == 16 && keywordBefore(input, start, imeiKeywordWindow, imeiKeywords) != "":
+			// IMEISV: TAC + serial + 2-digit software version
+		default:
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: start,
+			EndIndex:   end,
+			Value:      input[start:end],
+			Type:       TypeIMEI,
+			Score:      1.0,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+// NewIMEIDetector detects IMEI (15 digits, Luhn) and IMEISV (16 digits, after
+// an "IMEI"/"IMEISV" label) device identifiers, plain or as 35-209900-176148-1.
+func NewIMEIDetector() Detector {
+	return &IMEIDetector{}
+}
+
+// Separators split the TAC (8 digits, often printed 2+6) from the serial
+// number (6) and the check digit or software version.
+func validIMEISeparator(count int) bool {
+	return count == 2 || count == 8 || count == 14
+}
+
+// isIMEIReportingBody checks the first two TAC digits against the GSMA
+// reporting body identifiers in use.
+func isIMEIReportingBody(a, b byte) bool {
+	switch int(a-'0')*10 + int(b-'0') {
+	case 1, 10, 30, 33, 35, 44, 45, 49, 50, 51, 52, 53, 54, 86, 91, 98, 99:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,64 @@
+package detectors
+
+import "testing"
+
+func TestIMEIDetector(t *testing.T) {
+	d := NewIMEIDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Plain IMEI", "Device 490154203237518 activated.", 1},
+		{"Dashed IMEI", "IMEI: 35-209900-176148-1", 1},
+		{"Spaced IMEI", "IMEI 35 209900 176148 1", 1},
+		{"Labelled IMEISV", "IMEISV: 35-209900-176148-23", 1},
+		{"IMEISV With IMEI Label", "imei 3520990017614823", 1},
+
+		// Invalid Cases
+		{"Invalid Luhn", "Device 490154203237519", 0},
+		{"Unknown Reporting Body", "Device 720154203237512", 0},
+		{"IMEISV Without Label", "Order 3520990017614823", 0},
+		{"Wrong Grouping", "IMEI 352-09900-176148-1", 0},
+		{"Too Long", "IMEI 35209900176148123", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "IMEI 490154203237518 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIMEIDetector_NotCreditCard(t *testing.T) {
+	// A Luhn-valid IMEISV in the JCB range must only be reported as an IMEI.
+	input := "IMEISV 3520990017614808"
+	if got := len(NewCreditCardDetector().Scan(input)); got != 0 {
+		t.Errorf("credit card detector matched an IMEI: %d matches", got)
+	}
+	if got := len(NewIMEIDetector().Scan(input)); got != 1 {
+		t.Errorf("expected 1 IMEI match, got %d", got)
+	}
+}
+
+// Run with: go test -fuzz=FuzzIMEI -fuzztime=10s
+func FuzzIMEIDetector(f *testing.F) {
+	d := NewIMEIDetector()
+
+	f.Add("35-209900-176148-1")
+	f.Add("IMEISV 3520990017614823")
+	f.Add("imei 35")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -7,7 +7,16 @@ func (d *IPDetector) Name() string {
 }
 
 func (d *IPDetector) Scan(input string) []Match {
-	var results []Match
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *IPDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *IPDetector) scan(dst []Match, input string) []Match {
+	results := dst
 	for i := 0; i < len(input); i++ {
 		if !isDigitChar(input[i]) {
 			continue
@@ -0,0 +1,87 @@
+package detectors
+
+import "math/bits"
+
+// Keccak-256 as used by Ethereum (original Keccak padding, not SHA3-256).
+// It lives here so EIP-55 validation does not pull in x/crypto.
+
+var keccakRoundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+var keccakRotations = [24]int{
+	1, 3, 6, 10, 15, 21, 28, 36, 45, 55, 2, 14, 27, 41, 56, 8, 25, 43, 62, 18, 39, 61, 20, 44,
+}
+
+var keccakPiLanes = [24]int{
+	10, 7, 11, 17, 18, 3, 5, 16, 8, 21, 24, 4, 15, 23, 19, 13, 12, 2, 20, 14, 22, 9, 6, 1,
+}
+
+func keccakF1600(st *[25]uint64) {
+	var bc [5]uint64
+	for round := 0; round < 24; round++ {
+		// Theta
+		for i := 0; i < 5; i++ {
+			bc[i] = st[i] ^ st[i+5] ^ st[i+10] ^ st[i+15] ^ st[i+20]
+		}
+		for i := 0; i < 5; i++ {
+			t := bc[(i+4)%5] ^ bits.RotateLeft64(bc[(i+1)%5], 1)
+			for j := 0; j < 25; j += 5 {
+				st[j+i] ^= t
+			}
+		}
+
+		// Rho and Pi
+		t := st[1]
+		for i := 0; i < 24; i++ {
+			j := keccakPiLanes[i]
+			next := st[j]
+			st[j] = bits.RotateLeft64(t, keccakRotations[i])
+			t = next
+		}
+
+		// Chi
+		for j := 0; j < 25; j += 5 {
+			for i := 0; i < 5; i++ {
+				bc[i] = st[j+i]
+			}
+			for i := 0; i < 5; i++ {
+				st[j+i] ^= ^bc[(i+1)%5] & bc[(i+2)%5]
+			}
+		}
+
+		// Iota
+		st[0] ^= keccakRoundConstants[round]
+	}
+}
+
+// keccak256 hashes data without allocating.
+func keccak256(data string) [32]byte {
+	const rate = 136
+	var st [25]uint64
+
+	for len(data) >= rate {
+		for i := 0; i < rate; i++ {
+			st[i/8] ^= uint64(data[i]) << (8 * (i % 8))
+		}
+		keccakF1600(&st)
+		data = data[rate:]
+	}
+	for i := 0; i < len(data); i++ {
+		st[i/8] ^= uint64(data[i]) << (8 * (i % 8))
+	}
+	st[len(data)/8] ^= 0x01 << (8 * (len(data) % 8))
+	st[(rate-1)/8] ^= 0x80 << (8 * ((rate - 1) % 8))
+	keccakF1600(&st)
+
+	var out [32]byte
+	for i := 0; i < 32; i++ {
+		out[i] = byte(st[i/8] >> (8 * (i % 8)))
+	}
+	return out
+}
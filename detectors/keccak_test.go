@@ -0,0 +1,23 @@
+package detectors
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestKeccak256(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"abc", "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+	}
+
+	for _, tt := range tests {
+		sum := keccak256(tt.input)
+		if got := hex.EncodeToString(sum[:]); got != tt.want {
+			t.Errorf("keccak256(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
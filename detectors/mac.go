@@ -0,0 +1,169 @@
+package detectors
+
+type MACDetector struct{}
+
+func (d *MACDetector) Name() string {
+	return "global_mac_address"
+}
+
+func (d *MACDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *MACDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *MACDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	for i := 0; i < len(input); i++ {
+		if !isHexChar(input[i]) {
+			continue
+		}
+		if end, ok := matchMAC(input, i); ok {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeMAC,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+	return results
+}
+
+// NewMACDetector detects MAC addresses written as 00:1A:2B:3C:4D:5E,
+// 00-1A-2B-3C-4D-5E or in Cisco dotted form 001a.2b3c.4d5e.
+func NewMACDetector() Detector {
+	return &MACDetector{}
+}
+
+func matchMAC(s string, start int) (int, bool) {
+	// Boundary: an IPv6 address or longer hex run cannot precede a MAC
+	if start > 0 {
+		prev := s[start-1]
+		if isAlnumChar(prev) || prev == ':' || prev == '-' || prev == '.' {
+			return 0, false
+		}
+	}
+
+	var end int
+	var ok bool
+	if start+4 < len(s) && s[start+4] == '.' {
+		end, ok = matchCiscoMAC(s, start)
+	} else {
+		end, ok = matchColonMAC(s, start)
+	}
+	if !ok {
+		return 0, false
+	}
+
+	// Boundary: more groups means IPv6 or a longer identifier
+	if end < len(s) {
+		next := s[end]
+		if isAlnumChar(next) {
+			return 0, false
+		}
+		if (next == ':' || next == '-' || next == '.') && end+1 < len(s) && isHexChar(s[end+1]) {
+			return 0, false
+		}
+	}
+
+	if !isPlausibleMAC(s[start:end]) {
+		return 0, false
+	}
+	// All-decimal values are far more likely to be timestamps or version
+	// strings, unless labelled as a MAC
+	if isAllDecimalMAC(s[start:end]) && keywordBefore(s, start, macWindow, macKeywords) == "" {
+		return 0, false
+	}
+	return end, true
+}
+
+// macWindow is how far before the address its label may appear.
+const macWindow = 24
+
+var macKeywords = []string{
+	"mac", "hwaddr", "ether", "bssid", "hardware address", "physical address",
+}
+
+// matchColonMAC reads six 2-hex groups separated consistently by ':' or '-'.
+func matchColonMAC(s string, start int) (int, bool) {
+	if start+17 > len(s) {
+		return 0, false
+	}
+	sep := s[start+2]
+	if sep != ':' && sep != '-' {
+		return 0, false
+	}
+	idx := start
+	for group := 0; group < 6; group++ {
+		if !isHexChar(s[idx]) || !isHexChar(s[idx+1]) {
+			return 0, false
+		}
+		idx += 2
+		if group < 5 {
+			if s[idx] != sep {
+				return 0, false
+			}
+			idx++
+		}
+	}
+	return idx, true
+}
+
+// matchCiscoMAC reads three 4-hex groups separated by '.'.
+func matchCiscoMAC(s string, start int) (int, bool) {
+	if start+14 > len(s) {
+		return 0, false
+	}
+	idx := start
+	for group := 0; group < 3; group++ {
+		for k := 0; k < 4; k++ {
+			if !isHexChar(s[idx]) {
+				return 0, false
+			}
+			idx++
+		}
+		if group < 2 {
+			if s[idx] != '.' {
+				return 0, false
+			}
+			idx++
+		}
+	}
+	return idx, true
+}
+
+// isPlausibleMAC rejects the all-zero and broadcast addresses, which identify
+// nobody.
+func isPlausibleMAC(mac string) bool {
+	allZero, allF := true, true
+	for i := 0; i < len(mac); i++ {
+		c := mac[i]
+		if !isHexChar(c) {
+			continue
+		}
+		if c != '0' {
+			allZero = false
+		}
+		if c != 'f' && c != 'F' {
+			allF = false
+		}
+	}
+	return !allZero && !allF
+}
+
+// isAllDecimalMAC reports whether mac has no hex letter, as in
+// 10:20:30:40:50:59.
+func isAllDecimalMAC(mac string) bool {
+	for i := 0; i < len(mac); i++ {
+		if isHexChar(mac[i]) && !isDigitChar(mac[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package detectors
+
+import "testing"
+
+func TestMACDetector(t *testing.T) {
+	d := NewMACDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Colon Format", "Device 00:1A:2B:3C:4D:5E joined.", 1},
+		{"Dash Format", "MAC: 00-1a-2b-3c-4d-5e", 1},
+		{"Cisco Format", "Port learned 001a.2b3c.4d5e", 1},
+		{"Lowercase", "wlan0 ether a4:5e:60:c2:11:9f", 1},
+		{"Two MACs", "src=00:1A:2B:3C:4D:5E dst=a4:5e:60:c2:11:9f", 2},
+		{"Labelled All Decimal", "mac 00:14:22:01:23:45", 1},
+		{"Labelled All Decimal Dash", "MAC address: 00-14-22-01-23-45", 1},
+
+		// IPv6 & Timestamps
+		{"IPv6 Address", "Host fe80::1a2b:3c4d:5e6f:7a8b", 0},
+		{"IPv6 Full", "Host 2001:0db8:85a3:0000:0000:8a2e:0370:7334", 0},
+		{"IPv6 Mapped Tail", "Addr ::ff:1a:2b:3c:4d:5e", 0},
+		{"Seven Groups", "Addr 00:1A:2B:3C:4D:5E:6F", 0},
+		{"Timestamp", "At 12:30:45 the job ran", 0},
+		{"All Decimal Groups", "Seq 10:20:30:40:50:59", 0},
+		{"Unlabelled All Decimal", "Dell OUI 00:14:22:01:23:45", 0},
+
+		// Invalid Cases
+		{"Mixed Separators", "MAC 00:1A-2B:3C:4D:5E", 0},
+		{"Broadcast", "MAC ff:ff:ff:ff:ff:ff", 0},
+		{"All Zeros", "MAC 00:00:00:00:00:00", 0},
+		{"Non Hex", "MAC 00:1G:2B:3C:4D:5E", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "MAC 00:1A:2B:3C:4D:5E 🚀", 1},
+		{"Sentence End", "The MAC is 00:1A:2B:3C:4D:5E.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzMAC -fuzztime=10s
+func FuzzMACDetector(f *testing.F) {
+	d := NewMACDetector()
+
+	f.Add("00:1A:2B:3C:4D:5E")
+	f.Add("001a.2b3c.4d5e")
+	f.Add("fe80::1")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
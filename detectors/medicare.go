@@ -0,0 +1,79 @@
+package detectors
+
+type MedicareDetector struct{}
+
+func (d *MedicareDetector) Name() string {
+	return "au_medicare"
+}
+
+func (d *MedicareDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *MedicareDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *MedicareDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [10]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		end, ok := readFixedDigits(input, i, digits[:], validMedicareSeparator)
+		if !ok || !isValidMedicareBytes(digits[:]) {
+			continue
+		}
+
+		// The individual reference number printed next to the name is
+		// frequently appended: 2123 45670 1/1 or 2123 45670 1-1.
+		if end+1 < len(input) && (input[end] == '/' || input[end] == '-') &&
+			input[end+1] >= '1' && input[end+1] <= '9' &&
+			(end+2 == len(input) || !isDigitChar(input[end+2])) {
+			end += 2
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeMedicare,
+			Score:      1.0,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+// NewMedicareDetector detects Australian Medicare card numbers (2123 45670 1).
+func NewMedicareDetector() Detector {
+	return &MedicareDetector{}
+}
+
+func validMedicareSeparator(c byte, count int) bool {
+	return c == ' ' && (count == 4 || count == 9)
+}
+
+// isValidMedicareBytes checks the card range (2-6), the weighted Mod10 check
+// digit in the 9th position and a non-zero issue number.
+func isValidMedicareBytes(m []byte) bool {
+	if m[0] < '2' || m[0] > '6' || m[9] == '0' {
+		return false
+	}
+
+	weights := [8]int{1, 3, 7, 9, 1, 3, 7, 9}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(m[i]-'0') * weights[i]
+	}
+
+	return int(m[8]-'0') == sum%10
+}
@@ -0,0 +1,59 @@
+package detectors
+
+import "testing"
+
+func TestMedicareDetector(t *testing.T) {
+	d := NewMedicareDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Spaced", "Medicare 2123 45670 1 on file.", 1},
+		{"Valid Plain", "Medicare: 2123456701", 1},
+		{"Valid With IRN", "Medicare 2950 38108 1/2", 1},
+
+		// Near Misses
+		{"Invalid Check Digit", "Medicare 2123 45671 1", 0},
+		{"Invalid Card Range", "Medicare 7123 45670 1", 0},
+		{"Zero Issue Number", "Medicare 2123 45670 0", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "Medicare 2123 45670 1 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzMedicare -fuzztime=10s
+func FuzzMedicareDetector(f *testing.F) {
+	d := NewMedicareDetector()
+
+	f.Add("2123 45670 1")
+	f.Add("2950381081/2")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
+
+func TestMedicareDetector_IRN(t *testing.T) {
+	d := NewMedicareDetector()
+	matches := d.Scan("Card 2950 38108 1/2, expires 05/27")
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 match, got %d", len(matches))
+	}
+	if matches[0].Value != "2950 38108 1/2" {
+		t.Errorf("expected IRN to be included, got %q", matches[0].Value)
+	}
+}
@@ -0,0 +1,176 @@
+package detectors
+
+// MRNDetector finds medical record numbers. Formats vary per institution, so
+// it works two ways:
+//
+//   - by keyword: a 5-15 character token holding digits right after "MRN",
+//     "medical record" or "prontuário" ("MRN: 00123456", "prontuário nº 98765")
+//   - by pattern: each entry of Patterns describes one institution's format,
+//     with '#' for a digit, '@' for a letter, '*' for a letter or digit and
+//     any other character matched literally (e.g. "MR-#######")
+type MRNDetector struct {
+	Patterns []string
+}
+
+var mrnKeywords = []string{
+	"mrn", "medical record", "med rec", "record number", "chart",
+	"prontuário", "prontuario", "registro do paciente",
+}
+
+// mrnFillers may sit between a keyword and the number; lowercase.
+var mrnFillers = []string{"number", "no", "nº", "num", "is", "é", "do", "paciente"}
+
+const (
+	mrnMinLen = 5
+	mrnMaxLen = 15
+)
+
+func (d *MRNDetector) Name() string {
+	return "mrn"
+}
+
+func (d *MRNDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *MRNDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *MRNDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i < len(input); i++ {
+		if !isAlnumChar(input[i]) || (i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '-')) {
+			continue
+		}
+
+		if end, ok := d.matchPatterns(input, i); ok {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeMRN,
+				Score:      1.0,
+			})
+			i = end - 1
+			continue
+		}
+
+		// Keyword, then the record number right after it
+		start := mrnKeywordEnd(input, i)
+		if start < 0 {
+			continue
+		}
+		end, ok := mrnToken(input, start)
+		if !ok {
+			continue
+		}
+		results = append(results, Match{
+			StartIndex: start,
+			EndIndex:   end,
+			Value:      input[start:end],
+			Type:       TypeMRN,
+			Score:      0.8,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewMRNDetector() Detector {
+	return &MRNDetector{}
+}
+
+// mrnKeywordEnd matches a keyword at i, then separators and filler words
+// ("MRN #", "medical record number:", "prontuário nº") and returns where the
+// record number starts, or -1.
+func mrnKeywordEnd(input string, i int) int {
+	j := -1
+	for _, kw := range mrnKeywords {
+		if hasPrefixFold(input[i:], kw) {
+			j = i + len(kw)
+			break
+		}
+	}
+	if j < 0 || (j < len(input) && isLetter(input[j])) {
+		return -1
+	}
+	for j < len(input) {
+		switch c := input[j]; {
+		case c == ' ' || c == ':' || c == '#' || c == '=' || c == '.':
+			j++
+			continue
+		case isLetter(c) || c >= 0x80:
+			k := streetWordEnd(input, j)
+			if isWordFold(input[j:k], mrnFillers) {
+				j = k
+				continue
+			}
+		}
+		break
+	}
+	if j >= len(input) || !isAlnumChar(input[j]) {
+		return -1
+	}
+	return j
+}
+
+// mrnToken reads a letters/digits/inner-hyphen token at i that holds at
+// least one digit and has a plausible record number length.
+func mrnToken(input string, i int) (int, bool) {
+	end, digits := i, 0
+	for end < len(input) && (isAlnumChar(input[end]) ||
+		(input[end] == '-' && end+1 < len(input) && isAlnumChar(input[end+1]))) {
+		if isDigitChar(input[end]) {
+			digits++
+		}
+		end++
+	}
+	n := end - i
+	return end, digits > 0 && n >= mrnMinLen && n <= mrnMaxLen
+}
+
+func (d *MRNDetector) matchPatterns(input string, i int) (int, bool) {
+	for _, p := range d.Patterns {
+		if end, ok := matchMRNPattern(input, i, p); ok {
+			return end, true
+		}
+	}
+	return 0, false
+}
+
+// matchMRNPattern matches one '#'/'@'/'*' pattern at i.
+func matchMRNPattern(input string, i int, pattern string) (int, bool) {
+	if len(pattern) == 0 || i+len(pattern) > len(input) {
+		return 0, false
+	}
+	for k := 0; k < len(pattern); k++ {
+		c := input[i+k]
+		switch pattern[k] {
+		case '#':
+			if !isDigitChar(c) {
+				return 0, false
+			}
+		case '@':
+			if !isLetter(c) {
+				return 0, false
+			}
+		case '*':
+			if !isAlnumChar(c) {
+				return 0, false
+			}
+		default:
+			if upperASCII(c) != upperASCII(pattern[k]) {
+				return 0, false
+			}
+		}
+	}
+	end := i + len(pattern)
+	if end < len(input) && isAlnumChar(input[end]) {
+		return 0, false
+	}
+	return end, true
+}
@@ -0,0 +1,86 @@
+package detectors
+
+import "testing"
+
+func TestMRNDetector(t *testing.T) {
+	d := NewMRNDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Keyword
+		{"MRN Colon", "MRN: 00123456", []string{"00123456"}},
+		{"MRN Hash", "Patient MRN #A-778812", []string{"A-778812"}},
+		{"Medical Record Number", "medical record number 4455667", []string{"4455667"}},
+		{"Prontuario", "Prontuário nº 98765-4", []string{"98765-4"}},
+
+		// Context
+		{"No Keyword", "Order 00123456 shipped", nil},
+		{"Only First Token", "MRN 1234567 admitted 2024-01-01", []string{"1234567"}},
+		{"Word After Keyword", "MRN pending, see 1234567", nil},
+
+		// Invalid Cases
+		{"Too Short", "MRN 1234", nil},
+		{"No Digits", "MRN ABCDEFG", nil},
+		{"Keyword Prefix", "MRNX 1234567", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Value)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMRNDetector_Patterns(t *testing.T) {
+	d := &MRNDetector{Patterns: []string{"MR-#######", "@@######"}}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{"Literal Prefix", "see MR-1234567 today", 1},
+		{"Case Insensitive Literal", "see mr-1234567 today", 1},
+		{"Letters And Digits", "record HC123456", 1},
+		{"Wrong Length", "see MR-123456 today", 0},
+		{"Glued", "see MR-12345678 today", 0},
+		{"Letters Where Digits", "see MR-12345AB today", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzMRN -fuzztime=10s
+func FuzzMRNDetector(f *testing.F) {
+	d := &MRNDetector{Patterns: []string{"MR-#######", "*"}}
+
+	f.Add("MRN: 00123456")
+	f.Add("prontuário nº")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,387 @@
+package detectors
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MultiScanner runs the email, CPF, CNPJ, card, IPv4, phone and UUID
+// detectors in a single pass over the input. Bytes are classified once;
+// the start of each digit run, hex run, '@' and '+' is handed to the
+// validators that can match there, and inputs holding none of them are
+// skipped outright. Matches are exactly those of the detectors run one
+// after the other, in the same order.
+//
+// Only plain configurations can be combined: obfuscated email and phone
+// matching and card companions need their own pass.
+type MultiScanner struct {
+	name    string
+	slots   []multiSlot // in detector order
+	rank    [multiSlotCount]int8
+	anchors byteClass // bytes that can start a match
+	filter  byteClass // bytes that any match contains
+}
+
+type multiSlot uint8
+
+const (
+	slotEmail multiSlot = iota
+	slotCPF
+	slotCNPJ
+	slotCard
+	slotIP
+	slotPhone
+	slotUUID
+	multiSlotCount
+)
+
+type byteClass uint8
+
+const (
+	classDigit byteClass = 1 << iota
+	classHexLetter
+	classAt
+	classPlus
+	classDash
+)
+
+var byteClasses = func() (t [256]byteClass) {
+	for c := '0'; c <= '9'; c++ {
+		t[c] = classDigit
+	}
+	for c := 'a'; c <= 'f'; c++ {
+		t[c] = classHexLetter
+		t[c-'a'+'A'] = classHexLetter
+	}
+	t['@'] = classAt
+	t['+'] = classPlus
+	t['-'] = classDash
+	return t
+}()
+
+// What can start a match of each slot, and what every match contains: a
+// digit, or an '@' for emails. A UUID may be all letters, but has dashes.
+var (
+	slotAnchors = [multiSlotCount]byteClass{
+		slotEmail: classAt,
+		slotCPF:   classDigit,
+		slotCNPJ:  classDigit,
+		slotCard:  classDigit,
+		slotIP:    classDigit,
+		slotPhone: classPlus,
+		slotUUID:  classDigit | classHexLetter,
+	}
+	slotFilters = [multiSlotCount]byteClass{
+		slotEmail: classAt,
+		slotCPF:   classDigit,
+		slotCNPJ:  classDigit,
+		slotCard:  classDigit,
+		slotIP:    classDigit,
+		slotPhone: classDigit,
+		slotUUID:  classDigit | classDash,
+	}
+)
+
+// NewMultiScanner combines ds into one scanner. Every detector must be
+// one of those MultiScanner supports, at most once; see Combine to group
+// an arbitrary list.
+func NewMultiScanner(ds ...Detector) (*MultiScanner, error) {
+	s := &MultiScanner{}
+	for i := range s.rank {
+		s.rank[i] = -1
+	}
+	names := make([]string, len(ds))
+	for i, d := range ds {
+		slot, ok := multiSlotOf(d)
+		if !ok {
+			return nil, fmt.Errorf("detector %q cannot be combined", d.Name())
+		}
+		if s.rank[slot] >= 0 {
+			return nil, fmt.Errorf("detector %q is combined twice", d.Name())
+		}
+		s.rank[slot] = int8(len(s.slots))
+		s.slots = append(s.slots, slot)
+		s.anchors |= slotAnchors[slot]
+		s.filter |= slotFilters[slot]
+		names[i] = d.Name()
+	}
+	s.name = strings.Join(names, "+")
+	return s, nil
+}
+
+// Combine returns ds with each run of two or more consecutive detectors
+// that MultiScanner supports replaced by one MultiScanner. Scanning with
+// the result finds the same matches, in the same order, as scanning with
+// ds.
+func Combine(ds []Detector) []Detector {
+	out := make([]Detector, 0, len(ds))
+	flush := func(group []Detector) {
+		if len(group) < 2 {
+			out = append(out, group...)
+			return
+		}
+		s, _ := NewMultiScanner(group...) // group holds supported detectors only
+		out = append(out, s)
+	}
+
+	start := 0
+	var seen [multiSlotCount]bool
+	for i, d := range ds {
+		slot, ok := multiSlotOf(d)
+		if ok && !seen[slot] {
+			seen[slot] = true
+			continue
+		}
+		flush(ds[start:i])
+		seen = [multiSlotCount]bool{}
+		start = i
+		if ok {
+			seen[slot] = true
+		} else {
+			out = append(out, d)
+			start = i + 1
+		}
+	}
+	flush(ds[start:])
+	return out
+}
+
+func multiSlotOf(d Detector) (multiSlot, bool) {
+	switch d := d.(type) {
+	case *EmailDetector:
+		return slotEmail, !d.Obfuscated
+	case *CPFDetector:
+		return slotCPF, true
+	case *CNPJDetector:
+		return slotCNPJ, true
+	case *CreditCardDetector:
+		return slotCard, !d.Companions
+	case *IPDetector:
+		return slotIP, true
+	case *PhoneDetector:
+		return slotPhone, !d.Obfuscated
+	case *UUIDDetector:
+		return slotUUID, true
+	}
+	return 0, false
+}
+
+func (s *MultiScanner) Name() string {
+	return s.name
+}
+
+func (s *MultiScanner) Scan(input string) []Match {
+	return ownMetadata(s.scan(nil, input))
+}
+
+// ScanAppend implements AppendScanner.
+func (s *MultiScanner) ScanAppend(dst []Match, input []byte) []Match {
+	return s.scan(dst, bytesToString(input))
+}
+
+func (s *MultiScanner) scan(dst []Match, input string) []Match {
+	if !s.mayMatch(input) {
+		return dst
+	}
+
+	results := dst
+	// Where each detector resumes, as it skips over its own matches
+	var next [multiSlotCount]int
+
+	for i := 0; i < len(input); i++ {
+		class := byteClasses[input[i]] & s.anchors
+		if class == 0 {
+			continue
+		}
+		var prev byteClass
+		if i > 0 {
+			prev = byteClasses[input[i-1]]
+		}
+
+		switch {
+		case class&classDigit != 0:
+			// Start of a digit run; digit detectors never start inside one
+			if prev&classDigit == 0 {
+				results = s.scanDigitRun(results, input, i, prev, &next)
+			}
+			for i+1 < len(input) && byteClasses[input[i+1]]&classDigit != 0 {
+				i++
+			}
+		case class&classHexLetter != 0:
+			// Start of a hex run
+			if prev&(classDigit|classHexLetter|classDash) == 0 {
+				results = s.scanUUID(results, input, i, &next)
+			}
+			// Letters further in cannot start anything; digits may
+			for i+1 < len(input) && byteClasses[input[i+1]]&classHexLetter != 0 {
+				i++
+			}
+		case class&classAt != 0:
+			if i >= next[slotEmail] {
+				if start, end, ok := extractEmail(input, i); ok {
+					results = append(results, Match{
+						StartIndex: start,
+						EndIndex:   end,
+						Value:      input[start:end],
+						Type:       TypeEmail,
+						Score:      1.0,
+					})
+					next[slotEmail] = end
+				}
+			}
+		case class&classPlus != 0:
+			if i >= next[slotPhone] {
+				end, ok, resume := matchPhoneAt(input, i)
+				if ok {
+					results = append(results, Match{
+						StartIndex: i,
+						EndIndex:   end,
+						Value:      input[i:end],
+						Type:       TypePhone,
+						Score:      1.0,
+					})
+				}
+				next[slotPhone] = resume
+			}
+		}
+	}
+
+	// The pass finds matches by position; list them by detector
+	if len(s.slots) > 1 && len(results)-len(dst) > 1 {
+		slices.SortStableFunc(results[len(dst):], func(a, b Match) int {
+			return int(s.rank[typeSlot(a.Type)]) - int(s.rank[typeSlot(b.Type)])
+		})
+	}
+	return results
+}
+
+// mayMatch is the prefilter: it reports whether input holds a byte every
+// match of some detector contains.
+func (s *MultiScanner) mayMatch(input string) bool {
+	for i := 0; i < len(input); i++ {
+		if byteClasses[input[i]]&s.filter != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// scanDigitRun runs the digit detectors at i, the start of a digit run
+// preceded by a byte of class prev.
+func (s *MultiScanner) scanDigitRun(results []Match, input string, i int, prev byteClass, next *[multiSlotCount]int) []Match {
+	for _, slot := range s.slots {
+		if i < next[slot] {
+			continue
+		}
+		switch slot {
+		case slotCPF:
+			if end, ok := matchCPFAt(input, i); ok {
+				results = append(results, Match{
+					StartIndex: i,
+					EndIndex:   end,
+					Value:      input[i:end],
+					Type:       TypeCPF,
+					Score:      1.0,
+				})
+				next[slot] = end
+			}
+		case slotCNPJ:
+			if end, ok := matchCNPJAt(input, i); ok {
+				results = append(results, Match{
+					StartIndex: i,
+					EndIndex:   end,
+					Value:      input[i:end],
+					Type:       TypeCNPJ,
+					Score:      1.0,
+				})
+				next[slot] = end
+			}
+		case slotCard:
+			end, brand, score, resume := matchCardAt(input, i)
+			if brand != "" {
+				results = append(results, Match{
+					StartIndex: i,
+					EndIndex:   end,
+					Value:      input[i:end],
+					Type:       TypeCreditCard,
+					Score:      score,
+					Metadata:   cardBrandMetadata[brand],
+				})
+			}
+			next[slot] = resume
+		case slotIP:
+			if start, end, ok := matchIPv4(input, i); ok {
+				results = append(results, Match{
+					StartIndex: start,
+					EndIndex:   end,
+					Value:      input[start:end],
+					Type:       TypeIP,
+					Score:      1.0,
+				})
+				next[slot] = end
+			}
+		case slotUUID:
+			if prev&(classHexLetter|classDash) == 0 {
+				results = s.scanUUID(results, input, i, next)
+			}
+		}
+	}
+	return results
+}
+
+func (s *MultiScanner) scanUUID(results []Match, input string, i int, next *[multiSlotCount]int) []Match {
+	if i < next[slotUUID] || !uuidPrefix(input, i) {
+		return results
+	}
+	end, ok := matchUUID(input, i)
+	if !ok {
+		return results
+	}
+	m := Match{
+		StartIndex: i,
+		EndIndex:   end,
+		Value:      input[i:end],
+		Type:       TypeUUID,
+		Score:      1.0,
+	}
+	if kind := advertisingIDKind(input, i); kind != "" {
+		m.Type = TypeDeviceID
+		m.Metadata = deviceIDMetadata[kind]
+	}
+	next[slotUUID] = end
+	return append(results, m)
+}
+
+// uuidPrefix reports whether input[i:] starts with the first group of a
+// UUID: 8 hex digits and a dash. Most hex runs are words or numbers that
+// fail this cheaply.
+func uuidPrefix(input string, i int) bool {
+	if i+8 >= len(input) || input[i+8] != '-' {
+		return false
+	}
+	for j := i; j < i+8; j++ {
+		if !isHexChar(input[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+func typeSlot(typ PIIType) multiSlot {
+	switch typ {
+	case TypeEmail:
+		return slotEmail
+	case TypeCPF:
+		return slotCPF
+	case TypeCNPJ:
+		return slotCNPJ
+	case TypeCreditCard:
+		return slotCard
+	case TypeIP:
+		return slotIP
+	case TypePhone:
+		return slotPhone
+	}
+	return slotUUID // UUID or DEVICE_ID
+}
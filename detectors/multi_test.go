@@ -0,0 +1,180 @@
+package detectors
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// standardDetectors are the detectors MultiScanner combines, in the order
+// veil enables them.
+func standardDetectors() []Detector {
+	return []Detector{
+		NewEmailDetector(), NewCPFDetector(), NewCNPJDetector(), NewCreditCardDetector(),
+		NewIPDetector(), NewPhoneDetector(), NewUUIDDetector(),
+	}
+}
+
+// scanEach scans input with every detector in turn, as veil did before
+// detectors were combined.
+func scanEach(ds []Detector, input string) []Match {
+	var matches []Match
+	for _, d := range ds {
+		matches = append(matches, d.Scan(input)...)
+	}
+	return matches
+}
+
+var multiScanInputs = []string{
+	"",
+	"no pii at all",
+	appendScanSample,
+	"Contact john.doe@example.com or +1 555 010 9999, CPF 111.444.777-35",
+	"CNPJ 00.000.000/0001-91 and card 4111 1111 1111 1111, from 10.0.0.1:8080",
+	"4111111111111111111111 is too long, 41111111111111112 is not a card",
+	"ids 123e4567-e89b-12d3-a456-426614174000 abcdefab-abcd-abcd-abcd-abcdefabcdef",
+	"idfa=6D92078A-8246-4BA4-AE5B-76104861E7DC ab111.444.777-35 x11144477735y",
+	"+5511999999999+5511999999999 ++55 11 99999-9999 +1234567890123456789",
+	"a@b.c @@ x@ @y 1.2.3.4.5 01.2.3.4 255.255.255.255 256.1.1.1",
+	"imei 4111 1111 1111 1111, card 5500 0000 0000 0004-",
+	"11144477735111444777351114447773511144477735",
+	"f00d-123e4567-e89b-12d3-a456-426614174000 0-123e4567-e89b-12d3-a456-426614174000",
+}
+
+func TestMultiScanner_MatchesDetectors(t *testing.T) {
+	ds := standardDetectors()
+	s, err := NewMultiScanner(ds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, input := range multiScanInputs {
+		if got, want := s.Scan(input), scanEach(ds, input); !reflect.DeepEqual(got, want) {
+			t.Errorf("input %q:\n got  %v\n want %v", input, got, want)
+		}
+	}
+}
+
+// Random inputs built from the bytes the detectors care about, so that
+// partial and overlapping candidates are common.
+func TestMultiScanner_Differential(t *testing.T) {
+	pieces := []string{
+		"0", "1", "4", "9", "111.444.777-35", "00.000.000/0001-91", "4111 1111 1111 1111",
+		"192.168.0.1", "+55 11 99999-9999", "+1", "123e4567-e89b-12d3-a456-426614174000",
+		"a", "f", "G", "x", "-", ".", "/", " ", "@", "+", ":", "john@example.com", "é",
+		"imei ", "idfa=",
+	}
+	rng := rand.New(rand.NewSource(1))
+
+	for _, order := range [][]Detector{
+		standardDetectors(),
+		{NewUUIDDetector(), NewCreditCardDetector(), NewCPFDetector()},
+		{NewPhoneDetector(), NewEmailDetector()},
+	} {
+		s, err := NewMultiScanner(order...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for n := 0; n < 5000; n++ {
+			var sb strings.Builder
+			for k := rng.Intn(12); k >= 0; k-- {
+				sb.WriteString(pieces[rng.Intn(len(pieces))])
+			}
+			input := sb.String()
+			if got, want := s.Scan(input), scanEach(order, input); !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s, input %q:\n got  %v\n want %v", s.Name(), input, got, want)
+			}
+		}
+	}
+}
+
+func TestMultiScanner_Errors(t *testing.T) {
+	if _, err := NewMultiScanner(NewCPFDetector(), NewZIPDetector()); err == nil {
+		t.Error("expected an error for a detector that cannot be combined")
+	}
+	if _, err := NewMultiScanner(NewCPFDetector(), NewCPFDetector()); err == nil {
+		t.Error("expected an error for a detector combined twice")
+	}
+	if _, err := NewMultiScanner(&EmailDetector{Obfuscated: true}); err == nil {
+		t.Error("expected an error for obfuscated email matching")
+	}
+}
+
+func TestCombine(t *testing.T) {
+	zip := NewZIPDetector()
+	ds := []Detector{
+		NewEmailDetector(), NewCPFDetector(), zip, NewIPDetector(),
+		NewPhoneDetector(), NewPhoneDetector(), &CreditCardDetector{Companions: true},
+	}
+
+	var names []string
+	for _, d := range Combine(ds) {
+		names = append(names, d.Name())
+	}
+	want := []string{
+		"email+br_cpf", "us_zip", "global_ipv4+global_phone_e164",
+		"global_phone_e164", "global_credit_card",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Combine = %v, want %v", names, want)
+	}
+}
+
+// Run with: go test -fuzz=FuzzMultiScanner -fuzztime=10s
+func FuzzMultiScanner(f *testing.F) {
+	ds := standardDetectors()
+	s, _ := NewMultiScanner(ds...)
+
+	for _, input := range multiScanInputs {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC, and must agree with the detectors
+		if got, want := s.Scan(orig), scanEach(ds, orig); !reflect.DeepEqual(got, want) {
+			t.Errorf("input %q:\n got  %v\n want %v", orig, got, want)
+		}
+	})
+}
+
+var multiScanPayload = strings.Repeat(`
+Customer john.doe@example.com paid with 4111 1111 1111 1111 from 192.168.0.1.
+CPF 111.444.777-35, CNPJ 00.000.000/0001-91, phone +55 11 99999-9999.
+Request 123e4567-e89b-12d3-a456-426614174000 completed in 35 ms.
+`, 8)
+
+func BenchmarkMultiScanner(b *testing.B) {
+	s, _ := NewMultiScanner(standardDetectors()...)
+	payload := []byte(multiScanPayload)
+	dst := make([]Match, 0, 128)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = s.ScanAppend(dst[:0], payload)
+	}
+}
+
+func BenchmarkMultiScanner_Separate(b *testing.B) {
+	ds := standardDetectors()
+	payload := []byte(multiScanPayload)
+	dst := make([]Match, 0, 128)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = dst[:0]
+		for _, d := range ds {
+			dst = d.(AppendScanner).ScanAppend(dst, payload)
+		}
+	}
+}
+
+func BenchmarkMultiScanner_Prefilter(b *testing.B) {
+	s, _ := NewMultiScanner(standardDetectors()...)
+	payload := []byte(strings.Repeat("Nothing sensitive here, just words and punctuation. ", 40))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = s.ScanAppend(nil, payload)
+	}
+}
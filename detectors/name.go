@@ -0,0 +1,296 @@
+package detectors
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strings"
+	"sync"
+)
+
+// NameDetector finds person names offline. It looks at runs of capitalized
+// words ("Maria da Silva", "John Smith") and scores them with:
+//
+//   - bundled first-name and surname dictionaries (Brazilian, US, Hispanic)
+//   - honorifics and introductions right before the run ("Sr.", "Dr.",
+//     "Mrs.", "meu nome é", "my name is"), which also vouch for unknown names
+//   - salutations ("Dear", "Olá", "Hi"), a weaker cue
+//
+// A lone first name ("Maria") scores below the default threshold unless a
+// cue precedes it; a first name plus a known surname passes.
+type NameDetector struct {
+	// MinScore is the score a run needs to be reported. Zero means
+	// DefaultNameMinScore.
+	MinScore float32
+
+	// FirstNames and Surnames extend the bundled dictionaries.
+	// Entries are matched case-insensitively.
+	FirstNames []string
+	Surnames   []string
+
+	once   sync.Once
+	first  map[string]struct{}
+	family map[string]struct{}
+}
+
+// DefaultNameMinScore is the default NameDetector.MinScore.
+const DefaultNameMinScore = 0.6
+
+const (
+	nameFirstScore     = 0.5
+	nameSurnameScore   = 0.2
+	nameStrongCueScore = 0.6
+	nameWeakCueScore   = 0.3
+
+	// nameMaxWords bounds a run ("José Carlos de Souza Lima").
+	nameMaxWords = 5
+	// nameMaxWordLen bounds the bytes of a word looked up in the dictionaries.
+	nameMaxWordLen = 32
+)
+
+//go:embed names/first_names.txt
+var bundledFirstNames string
+
+//go:embed names/surnames.txt
+var bundledSurnames string
+
+var (
+	bundledNamesOnce  sync.Once
+	bundledFirstSet   map[string]struct{}
+	bundledSurnameSet map[string]struct{}
+)
+
+// nameConnectors may join the words of a name ("Maria da Silva").
+var nameConnectors = []string{"da", "de", "do", "das", "dos", "del", "della", "la", "van", "von", "der", "di", "e", "y"}
+
+// nameStrongCues are honorifics and introductions; lowercase.
+var nameStrongCues = []string{
+	"sr.", "sra.", "srta.", "dr.", "dra.", "mr.", "mrs.", "ms.", "prof.", "profa.",
+	"sr", "sra", "dr", "dra", "mr", "mrs", "ms", "miss",
+	"meu nome é", "me chamo", "my name is", "me llamo", "mi nombre es",
+	"name:", "nome:", "nombre:", "paciente", "patient",
+}
+
+// nameWeakCues are salutations, also used before non-names ("Hello World").
+var nameWeakCues = []string{
+	"dear", "caro", "cara", "prezado", "prezada", "querido", "querida",
+	"estimado", "estimada", "olá", "ola", "oi", "hi", "hello", "hola",
+}
+
+func (d *NameDetector) Name() string {
+	return "person_name"
+}
+
+func (d *NameDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NameDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NameDetector) scan(dst []Match, input string) []Match {
+	d.once.Do(d.init)
+
+	minScore := d.MinScore
+	if minScore == 0 {
+		minScore = DefaultNameMinScore
+	}
+
+	results := dst
+
+	for i := 0; i < len(input); i++ {
+		if (i > 0 && (isAlnumChar(input[i-1]) || input[i-1] >= 0x80)) || !isCapitalized(input, i) {
+			continue
+		}
+
+		end, score, ok := d.scoreNameRun(input, i)
+		if !ok {
+			continue
+		}
+		score += nameCueBefore(input, i)
+		if score > 1 {
+			score = 1
+		}
+		if score < minScore {
+			// The run may still hold a name after a capitalized non-name
+			// ("Yesterday John Smith"); retry from its next word.
+			i = nameWordEnd(input, i) - 1
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeName,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+// NewNameDetector returns a NameDetector using the bundled dictionaries.
+func NewNameDetector() Detector {
+	return &NameDetector{}
+}
+
+// ParseNameList reads a name list with one name per line. Blank lines and
+// lines starting with '#' are skipped.
+func ParseNameList(r io.Reader) ([]string, error) {
+	var names []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		names = append(names, line)
+	}
+	return names, scanner.Err()
+}
+
+func loadBundledNames() {
+	bundledFirstSet = nameSet(bundledFirstNames, nil)
+	bundledSurnameSet = nameSet(bundledSurnames, nil)
+}
+
+func nameSet(list string, extra []string) map[string]struct{} {
+	names, _ := ParseNameList(strings.NewReader(list))
+	set := make(map[string]struct{}, len(names)+len(extra))
+	for _, n := range names {
+		set[lowerASCII(n)] = struct{}{}
+	}
+	for _, n := range extra {
+		set[lowerASCII(strings.TrimSpace(n))] = struct{}{}
+	}
+	return set
+}
+
+func (d *NameDetector) init() {
+	bundledNamesOnce.Do(loadBundledNames)
+
+	d.first, d.family = bundledFirstSet, bundledSurnameSet
+	if len(d.FirstNames) > 0 {
+		d.first = nameSet(bundledFirstNames, d.FirstNames)
+	}
+	if len(d.Surnames) > 0 {
+		d.family = nameSet(bundledSurnames, d.Surnames)
+	}
+}
+
+// nameWordEnd returns the end of the capitalized word at i, or i if the word
+// is not Capitalized (all-caps words such as "NASA" are skipped).
+func nameWordEnd(input string, i int) int {
+	end := streetWordEnd(input, i)
+	hasLower := false
+	for k := i + 1; k < end; k++ {
+		// ASCII or Latin-1 lowercase (0xC3 0xA0-0xBF)
+		if (input[k] >= 'a' && input[k] <= 'z') || (input[k-1] == 0xC3 && input[k] >= 0xA0) {
+			hasLower = true
+			break
+		}
+	}
+	if !hasLower || end-i > nameMaxWordLen {
+		return i
+	}
+	return end
+}
+
+// inNameSet looks up a word, lowercased, without allocating.
+func inNameSet(set map[string]struct{}, word string) bool {
+	var buf [nameMaxWordLen]byte
+	if len(word) > len(buf) {
+		return false
+	}
+	for k := 0; k < len(word); k++ {
+		buf[k] = word[k]
+		if buf[k] >= 'A' && buf[k] <= 'Z' {
+			buf[k] += 'a' - 'A'
+		}
+	}
+	_, ok := set[string(buf[:len(word)])]
+	return ok
+}
+
+// scoreNameRun walks the run of capitalized words (and inner connectors) at
+// i and scores it from the dictionaries.
+func (d *NameDetector) scoreNameRun(input string, i int) (int, float32, bool) {
+	end := nameWordEnd(input, i)
+	if end == i {
+		return 0, 0, false
+	}
+
+	var score float32
+	if inNameSet(d.first, input[i:end]) {
+		score = nameFirstScore
+	}
+
+	words := 1
+	for words < nameMaxWords && end < len(input) && input[end] == ' ' {
+		j := end + 1
+		// Skip connectors such as "da" / "de la" when a capitalized word follows
+		for j < len(input) && !isCapitalized(input, j) {
+			k := streetWordEnd(input, j)
+			if k == j || k >= len(input) || input[k] != ' ' || !isWordFold(input[j:k], nameConnectors) {
+				break
+			}
+			j = k + 1
+		}
+		if j >= len(input) || !isCapitalized(input, j) {
+			break
+		}
+		k := nameWordEnd(input, j)
+		if k == j {
+			break
+		}
+		word := input[j:k]
+		if inNameSet(d.family, word) || inNameSet(d.first, word) {
+			score += nameSurnameScore
+		}
+		end = k
+		words++
+	}
+
+	if end < len(input) && (isAlnumChar(input[end]) || input[end] >= 0x80) {
+		return 0, 0, false
+	}
+	return end, score, true
+}
+
+// nameCueBefore scores the honorific, introduction or salutation right
+// before i, if any.
+func nameCueBefore(input string, i int) float32 {
+	j := i
+	for j > 0 && (input[j-1] == ' ' || input[j-1] == ',') {
+		j--
+	}
+	if j == i {
+		return 0
+	}
+	if hasCueSuffix(input[:j], nameStrongCues) {
+		return nameStrongCueScore
+	}
+	if hasCueSuffix(input[:j], nameWeakCues) {
+		return nameWeakCueScore
+	}
+	return 0
+}
+
+// hasCueSuffix reports whether s ends with one of cues (lowercase) starting
+// at a word boundary.
+func hasCueSuffix(s string, cues []string) bool {
+	for _, cue := range cues {
+		start := len(s) - len(cue)
+		if start < 0 || !hasPrefixFold(s[start:], cue) {
+			continue
+		}
+		if start == 0 || !(isLetter(s[start-1]) || s[start-1] >= 0x80) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package detectors
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNameDetector(t *testing.T) {
+	d := NewNameDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Dictionary
+		{"First And Surname", "Ticket opened by João Silva yesterday", []string{"João Silva"}},
+		{"US Name", "Contact John Smith for access", []string{"John Smith"}},
+		{"Hispanic Name", "Cliente: Alejandro Hernández", []string{"Alejandro Hernández"}},
+		{"Connectors", "Maria da Silva Santos assinou", []string{"Maria da Silva Santos"}},
+		{"Sentence Start", "Yesterday Emily Johnson called.", []string{"Emily Johnson"}},
+
+		// Cues
+		{"Honorific Unknown Name", "Dr. Gregory House will see you", []string{"Gregory House"}},
+		{"Honorific Lone Surname", "Falar com o Sr. Alvarenga", []string{"Alvarenga"}},
+		{"Introduction", "Olá, meu nome é Ana", []string{"Ana"}},
+		{"Salutation", "Dear Maria, thanks!", []string{"Maria"}},
+
+		// Not names
+		{"Lone First Name", "Maria", nil},
+		{"Salutation Non Name", "Hello World", nil},
+		{"Place", "Flights to New York", nil},
+		{"Surnames Only", "Silva Santos Ltda", nil},
+		{"All Caps", "JOHN SMITH", nil},
+		{"Lowercase", "john smith", nil},
+		{"Glued", "xJohn Smith", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if len(matches) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %d matches, got %d: %+v", tt.input, len(tt.expected), len(matches), matches)
+			}
+			for k, m := range matches {
+				if m.Value != tt.expected[k] {
+					t.Errorf("match %d: expected %q, got %q", k, tt.expected[k], m.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestNameDetector_Config(t *testing.T) {
+	input := "Report by Zyra Quillfeather and Maria Alvarenga"
+
+	if got := NewNameDetector().Scan(input); len(got) != 0 {
+		t.Fatalf("expected no names with the bundled lists, got %+v", got)
+	}
+
+	custom := &NameDetector{FirstNames: []string{"Zyra"}, Surnames: []string{"quillfeather"}}
+	if got := custom.Scan(input); len(got) != 1 || got[0].Value != "Zyra Quillfeather" {
+		t.Errorf("expected custom name, got %+v", got)
+	}
+
+	lenient := &NameDetector{MinScore: 0.5}
+	if got := lenient.Scan(input); len(got) != 1 || got[0].Value != "Maria Alvarenga" {
+		t.Errorf("expected lower threshold to report the first name run, got %+v", got)
+	}
+}
+
+func TestParseNameList(t *testing.T) {
+	names, err := ParseNameList(strings.NewReader("# comment\nZyra\n\n  Quill  \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "Zyra" || names[1] != "Quill" {
+		t.Errorf("unexpected names %q", names)
+	}
+}
+
+func BenchmarkNameDetector(b *testing.B) {
+	d := NewNameDetector()
+	input := strings.Repeat("The Quick report was sent by João Silva to the Finance team. ", 20)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.Scan(input)
+	}
+}
+
+// Run with: go test -fuzz=FuzzName -fuzztime=10s
+func FuzzNameDetector(f *testing.F) {
+	d := NewNameDetector()
+
+	f.Add("Dr. Gregory House")
+	f.Add("Maria da Silva")
+	f.Add("Sr. Á")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,73 @@
+package detectors
+
+type NHSDetector struct{}
+
+func (d *NHSDetector) Name() string {
+	return "uk_nhs"
+}
+
+func (d *NHSDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NHSDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NHSDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [10]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		end, ok := readFixedDigits(input, i, digits[:], validNHSSeparator)
+		if ok && isValidNHSBytes(digits[:]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeNHS,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewNHSDetector detects NHS numbers (England, Wales, Isle of Man) written
+// as 943 476 5919, 943-476-5919 or 9434765919.
+func NewNHSDetector() Detector {
+	return &NHSDetector{}
+}
+
+func validNHSSeparator(c byte, count int) bool {
+	return (c == ' ' || c == '-') && (count == 3 || count == 6)
+}
+
+// isValidNHSBytes implements the NHS Mod11 check with weights 10..2.
+func isValidNHSBytes(nhs []byte) bool {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(nhs[i]-'0') * (10 - i)
+	}
+
+	digit := 11 - sum%11
+	switch digit {
+	case 11:
+		digit = 0
+	case 10:
+		// Never issued
+		return false
+	}
+
+	return int(nhs[9]-'0') == digit
+}
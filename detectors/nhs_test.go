@@ -0,0 +1,50 @@
+package detectors
+
+import "testing"
+
+func TestNHSDetector(t *testing.T) {
+	d := NewNHSDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Spaced", "NHS number 943 476 5919 on file.", 1},
+		{"Valid Dashed", "NHS: 401-023-2137", 1},
+		{"Valid Plain", "Patient 9434765919", 1},
+
+		// Near Misses
+		{"Invalid Checksum", "NHS 943 476 5918", 0},
+		{"Check Digit Ten", "NHS 123 456 7890", 0},
+		{"Wrong Grouping", "NHS 9434 76 5919", 0},
+		{"Too Long", "NHS 94347659190", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "NHS 943 476 5919 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzNHS -fuzztime=10s
+func FuzzNHSDetector(f *testing.F) {
+	d := NewNHSDetector()
+
+	f.Add("943 476 5919")
+	f.Add("9434765919")
+	f.Add("943-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,65 @@
+package detectors
+
+type NIEDetector struct{}
+
+func (d *NIEDetector) Name() string {
+	return "es_nie"
+}
+
+func (d *NIEDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NIEDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NIEDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [8]byte
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c != 'X' && c != 'Y' && c != 'Z' {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		// X, Y and Z stand for a leading 0, 1 and 2 in the checksum.
+		digits[0] = '0' + (c - 'X')
+		j := i + 1
+		if j < len(input) && input[j] == '-' {
+			j++
+		}
+		count := 1
+		for j < len(input) && count < 8 && isDigitChar(input[j]) {
+			digits[count] = input[j]
+			count++
+			j++
+		}
+		if count != 8 || (j < len(input) && isDigitChar(input[j])) {
+			continue
+		}
+
+		if end, ok := matchDNILetter(input, j); ok && input[end-1] == dniControlLetter(digits[:]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeNIE,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewNIEDetector detects Spanish foreigner IDs (X1234567L, Y-7654321-G).
+func NewNIEDetector() Detector {
+	return &NIEDetector{}
+}
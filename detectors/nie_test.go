@@ -0,0 +1,48 @@
+package detectors
+
+import "testing"
+
+func TestNIEDetector(t *testing.T) {
+	d := NewNIEDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid X", "NIE X1234567L registrado.", 1},
+		{"Valid Y Dashed", "NIE: Y-7654321-G", 1},
+
+		// Near Misses
+		{"Wrong Letter", "NIE X1234567T", 0},
+		{"Wrong Prefix", "NIE W1234567L", 0},
+		{"Six Digits", "NIE X123456L", 0},
+		{"Embedded In Word", "ABX1234567L", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "NIE X1234567L 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzNIE -fuzztime=10s
+func FuzzNIEDetector(f *testing.F) {
+	d := NewNIEDetector()
+
+	f.Add("X1234567L")
+	f.Add("Z-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
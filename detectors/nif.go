@@ -0,0 +1,79 @@
+package detectors
+
+type NIFDetector struct{}
+
+func (d *NIFDetector) Name() string {
+	return "pt_nif"
+}
+
+func (d *NIFDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NIFDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NIFDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [9]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		end, ok := readFixedDigits(input, i, digits[:], validNIFSeparator)
+		if ok && isValidNIFBytes(digits[:]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeNIF,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewNIFDetector detects Portuguese tax numbers (NIF/NIPC), written as
+// 123456789 or 123 456 789.
+func NewNIFDetector() Detector {
+	return &NIFDetector{}
+}
+
+func validNIFSeparator(c byte, count int) bool {
+	return c == ' ' && (count == 3 || count == 6)
+}
+
+// isValidNIFBytes checks the entity type digit and the Mod11 check digit.
+func isValidNIFBytes(nif []byte) bool {
+	switch nif[0] {
+	case '1', '2', '3', '5', '6', '7', '8', '9':
+	case '4':
+		// 45 is reserved for non-resident individuals
+		if nif[1] != '5' {
+			return false
+		}
+	default:
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(nif[i]-'0') * (9 - i)
+	}
+	digit := 11 - sum%11
+	if digit >= 10 {
+		digit = 0
+	}
+
+	return int(nif[8]-'0') == digit
+}
@@ -0,0 +1,49 @@
+package detectors
+
+import "testing"
+
+func TestNIFDetector(t *testing.T) {
+	d := NewNIFDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Plain", "NIF 123456789 registado.", 1},
+		{"Valid Spaced", "NIF: 123 456 789", 1},
+		{"Valid Company", "NIPC 501234560", 1},
+
+		// Near Misses
+		{"Wrong Check Digit", "NIF 123456788", 0},
+		{"Invalid Type Digit", "NIF 023456789", 0},
+		{"Reserved Four", "NIF 412345678", 0},
+		{"Ten Digits", "NIF 1234567890", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "NIF 123456789 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzNIF -fuzztime=10s
+func FuzzNIFDetector(f *testing.F) {
+	d := NewNIFDetector()
+
+	f.Add("123 456 789")
+	f.Add("45")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,103 @@
+package detectors
+
+type NINODetector struct{}
+
+func (d *NINODetector) Name() string {
+	return "uk_nino"
+}
+
+func (d *NINODetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NINODetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NINODetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+9 <= len(input); i++ {
+		if !isUpperLetter(input[i]) {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		if end, ok := matchNINO(input, i); ok {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeNINO,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewNINODetector detects UK National Insurance numbers (QQ 12 34 56 C).
+func NewNINODetector() Detector {
+	return &NINODetector{}
+}
+
+// matchNINO reads two prefix letters, three digit pairs and the A-D suffix.
+// A single space is tolerated between each group.
+func matchNINO(s string, start int) (int, bool) {
+	n := len(s)
+	if start+1 >= n || !isUpperLetter(s[start+1]) {
+		return 0, false
+	}
+	if !isValidNINOPrefix(s[start], s[start+1]) {
+		return 0, false
+	}
+
+	idx := start + 2
+	for pair := 0; pair < 3; pair++ {
+		if idx < n && s[idx] == ' ' {
+			idx++
+		}
+		if idx+1 >= n || !isDigitChar(s[idx]) || !isDigitChar(s[idx+1]) {
+			return 0, false
+		}
+		idx += 2
+	}
+	if idx < n && s[idx] == ' ' {
+		idx++
+	}
+	if idx >= n || s[idx] < 'A' || s[idx] > 'D' {
+		return 0, false
+	}
+	idx++
+
+	if idx < n && isAlnumChar(s[idx]) {
+		return 0, false
+	}
+	return idx, true
+}
+
+// isValidNINOPrefix applies HMRC allocation rules: D, F, I, Q, U and V are
+// never used, O is not used as the second letter, and some pairs are reserved.
+func isValidNINOPrefix(a, b byte) bool {
+	switch a {
+	case 'D', 'F', 'I', 'Q', 'U', 'V':
+		return false
+	}
+	switch b {
+	case 'D', 'F', 'I', 'O', 'Q', 'U', 'V':
+		return false
+	}
+	switch {
+	case a == 'B' && b == 'G', a == 'G' && b == 'B',
+		a == 'K' && b == 'N', a == 'N' && b == 'K',
+		a == 'N' && b == 'T', a == 'T' && b == 'N',
+		a == 'Z' && b == 'Z':
+		return false
+	}
+	return true
+}
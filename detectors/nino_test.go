@@ -0,0 +1,52 @@
+package detectors
+
+import "testing"
+
+func TestNINODetector(t *testing.T) {
+	d := NewNINODetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Plain", "NI number AB123456C on file.", 1},
+		{"Valid Spaced", "NINO: AB 12 34 56 C", 1},
+		{"Valid Suffix D", "NINO JG103759D", 1},
+
+		// Near Misses
+		{"Invalid First Letter", "NINO DA123456C", 0},
+		{"Invalid Second Letter", "NINO AO123456C", 0},
+		{"Reserved Prefix", "NINO GB123456A", 0},
+		{"Administrative Prefix", "NINO ZZ123456A", 0},
+		{"Invalid Suffix", "NINO AB123456E", 0},
+		{"Missing Suffix", "NINO AB123456", 0},
+		{"Embedded In Word", "XAB123456C", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "NINO AB123456C 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzNINO -fuzztime=10s
+func FuzzNINODetector(f *testing.F) {
+	d := NewNINODetector()
+
+	f.Add("AB 12 34 56 C")
+	f.Add("AB123456")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
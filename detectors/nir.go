@@ -0,0 +1,123 @@
+package detectors
+
+type NIRDetector struct{}
+
+func (d *NIRDetector) Name() string {
+	return "fr_nir"
+}
+
+func (d *NIRDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NIRDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NIRDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		if end, ok := matchNIR(input, i); ok {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeNIR,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewNIRDetector detects French social security numbers (NIR), written as
+// 1 85 05 78 006 084 91 or as 15 contiguous characters.
+func NewNIRDetector() Detector {
+	return &NIRDetector{}
+}
+
+// nirGroups is the printed layout: sex, year, month, department, commune,
+// order number and the two-digit key.
+var nirGroups = [...]int{1, 2, 2, 2, 3, 3, 2}
+
+func matchNIR(s string, start int) (int, bool) {
+	var buf [15]byte
+	n := len(s)
+	idx := start
+	count := 0
+
+	for g, size := range nirGroups {
+		if g > 0 && idx < n && s[idx] == ' ' {
+			idx++
+		}
+		for k := 0; k < size; k++ {
+			if idx >= n {
+				return 0, false
+			}
+			c := s[idx]
+			// Corsican departments are 2A and 2B
+			corsica := g == 3 && k == 1 && buf[count-1] == '2' && (c == 'A' || c == 'B')
+			if !isDigitChar(c) && !corsica {
+				return 0, false
+			}
+			buf[count] = c
+			count++
+			idx++
+		}
+	}
+	if idx < n && isAlnumChar(s[idx]) {
+		return 0, false
+	}
+
+	if !isValidNIRBytes(buf[:]) {
+		return 0, false
+	}
+	return idx, true
+}
+
+// isValidNIRBytes validates the sex digit, month and the key, which equals
+// 97 minus the first 13 digits mod 97 (2A/2B count as 19/18).
+func isValidNIRBytes(nir []byte) bool {
+	switch nir[0] {
+	case '1', '2', '3', '4', '7', '8':
+	default:
+		return false
+	}
+
+	// 20-42 and 50-99 are assigned when the birth month is unknown.
+	month := int(nir[3]-'0')*10 + int(nir[4]-'0')
+	if month == 0 || (month > 12 && month < 20) || (month > 42 && month < 50) {
+		return false
+	}
+
+	rem := 0
+	for k := 0; k < 13; k++ {
+		c := nir[k]
+		switch {
+		case k == 5 && c == '2' && (nir[6] == 'A' || nir[6] == 'B'):
+			c = '1'
+		case k == 6 && c == 'A':
+			c = '9'
+		case k == 6 && c == 'B':
+			c = '8'
+		}
+		rem = (rem*10 + int(c-'0')) % 97
+	}
+	if !isDigitChar(nir[13]) || !isDigitChar(nir[14]) {
+		return false
+	}
+	key := int(nir[13]-'0')*10 + int(nir[14]-'0')
+
+	return key == 97-rem
+}
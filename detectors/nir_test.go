@@ -0,0 +1,50 @@
+package detectors
+
+import "testing"
+
+func TestNIRDetector(t *testing.T) {
+	d := NewNIRDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Spaced", "NIR 1 85 05 78 006 084 91 enregistré.", 1},
+		{"Valid Plain", "Sécu: 185057800608491", 1},
+		{"Valid Corsica", "NIR 1 85 05 2A 006 123 93", 1},
+		{"Valid Female", "NIR 2 69 01 75 116 038 13", 1},
+
+		// Near Misses
+		{"Wrong Key", "NIR 1 85 05 78 006 084 92", 0},
+		{"Invalid Sex Digit", "NIR 5 85 05 78 006 084 91", 0},
+		{"Invalid Month", "NIR 1 85 15 78 006 084 91", 0},
+		{"Too Long", "NIR 1850578006084911", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "NIR 185057800608491 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzNIR -fuzztime=10s
+func FuzzNIRDetector(f *testing.F) {
+	d := NewNIRDetector()
+
+	f.Add("1 85 05 78 006 084 91")
+	f.Add("1 85 05 2A")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,87 @@
+package detectors
+
+type NITDetector struct{}
+
+func (d *NITDetector) Name() string {
+	return "co_nit"
+}
+
+func (d *NITDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NITDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NITDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var body [10]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '.') {
+			continue
+		}
+
+		count, j, ok := readGroupedDigits(input, i, body[:])
+		if !ok {
+			continue
+		}
+		if count < 8 {
+			i = j
+			continue
+		}
+
+		// Without the dash a NIT is indistinguishable from any 10-digit number.
+		if j+1 >= len(input) || input[j] != '-' || !isDigitChar(input[j+1]) {
+			i = j
+			continue
+		}
+		end := j + 2
+		if end < len(input) && isAlnumChar(input[end]) {
+			i = j
+			continue
+		}
+
+		if isValidNITBytes(body[:count], input[j+1]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeNIT,
+				Score:      1.0,
+			})
+			i = end - 1
+			continue
+		}
+		i = j
+	}
+
+	return results
+}
+
+// NewNITDetector detects Colombian NIT numbers (900.123.456-8).
+func NewNITDetector() Detector {
+	return &NITDetector{}
+}
+
+// isValidNITBytes implements the DIAN Mod11 check using prime weights
+// applied from the rightmost digit of the body.
+func isValidNITBytes(body []byte, dv byte) bool {
+	weights := [10]int{3, 7, 13, 17, 19, 23, 29, 37, 41, 43}
+	sum := 0
+	for i := 0; i < len(body); i++ {
+		sum += int(body[len(body)-1-i]-'0') * weights[i]
+	}
+
+	digit := sum % 11
+	if digit >= 2 {
+		digit = 11 - digit
+	}
+
+	return int(dv-'0') == digit
+}
@@ -0,0 +1,51 @@
+package detectors
+
+import "testing"
+
+func TestNITDetector(t *testing.T) {
+	d := NewNITDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Formatted", "NIT 900.123.456-8 registrado.", 1},
+		{"Valid Second", "NIT: 860.001.022-7", 1},
+		{"Valid Undotted", "NIT 890900608-9", 1},
+
+		// Invalid Cases
+		{"Invalid Checksum", "NIT 900.123.456-7", 0},
+		{"Missing Dash", "NIT 9001234568", 0},
+		{"Bad Dot Grouping", "NIT 9001.23.456-8", 0},
+		{"Too Short", "NIT 123.456-7", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "NIT 900.123.456-8 🚀", 1},
+		{"Boundary Start", "900.123.456-8 es el NIT", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzNIT -fuzztime=10s
+func FuzzNITDetector(f *testing.F) {
+	d := NewNITDetector()
+
+	f.Add("900.123.456-8")
+	f.Add("890900608-9")
+	f.Add("900.")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
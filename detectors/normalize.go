@@ -0,0 +1,203 @@
+package detectors
+
+import "unicode/utf8"
+
+// NormalizeMode selects the rewrites applied by NormalizeWith.
+type NormalizeMode uint8
+
+const (
+	// NormalizeEquivalents replaces runes equivalent to ASCII for detection
+	// purposes by their ASCII form:
+	//
+	//   - full-width forms (U+FF01-U+FF5E): "１１１.４４４" -> "111.444", "＠" -> "@"
+	//   - decimal digits of other scripts: Arabic-Indic, Persian, Devanagari,
+	//     Bengali, Thai
+	//   - NBSP, thin and other fixed-width spaces -> ' '
+	//   - hyphens, dashes and the minus sign -> '-'
+	//   - ideographic full stops -> '.'
+	NormalizeEquivalents NormalizeMode = 1 << iota
+
+	// NormalizeInvisible drops zero-width characters, soft hyphens, the BOM
+	// and bidi controls, often slipped inside PII to split it
+	// ("4111\u200b1111...").
+	NormalizeInvisible
+
+	// NormalizeConfusables folds Cyrillic and Greek letters that look like
+	// Latin ones ("ex\u0430mple.com" -> "example.com").
+	NormalizeConfusables
+)
+
+// Normalized is a rewritten copy of an input, see NormalizeMode. Detectors
+// scan Text; Span maps match offsets back to the original input.
+type Normalized struct {
+	Text string
+
+	input string
+	mode  NormalizeMode
+
+	// offsets[k] is the original offset of Text[k], plus a final entry for
+	// len(input). Nil when Text is the input itself.
+	offsets []int
+}
+
+// unicodeDigitZeros lists the zero of each supported decimal digit block.
+var unicodeDigitZeros = []rune{
+	0x0660, // Arabic-Indic
+	0x06F0, // Extended Arabic-Indic (Persian, Urdu)
+	0x0966, // Devanagari
+	0x09E6, // Bengali
+	0x0E50, // Thai
+	0xFF10, // Full-width
+}
+
+// confusables maps look-alike Cyrillic and Greek letters to Latin ones.
+var confusables = map[rune]byte{
+	// Cyrillic
+	'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x',
+	'і': 'i', 'ј': 'j', 'ѕ': 's', 'ԁ': 'd', 'һ': 'h', 'ԛ': 'q', 'ԝ': 'w',
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O',
+	'Р': 'P', 'С': 'C', 'Т': 'T', 'Х': 'X', 'І': 'I', 'Ј': 'J', 'Ѕ': 'S',
+	// Greek
+	'ο': 'o', 'ν': 'v', 'ι': 'i',
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K',
+	'Μ': 'M', 'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+}
+
+// Normalize applies NormalizeEquivalents to input.
+func Normalize(input string) *Normalized {
+	return NormalizeWith(input, NormalizeEquivalents)
+}
+
+// NormalizeWith returns input rewritten by mode. Pure ASCII input is returned
+// as is, without allocating.
+func NormalizeWith(input string, mode NormalizeMode) *Normalized {
+	i := 0
+	for i < len(input) && input[i] < utf8.RuneSelf {
+		i++
+	}
+	if i == len(input) || !needsNormalize(input[i:], mode) {
+		return &Normalized{Text: input}
+	}
+
+	buf := make([]byte, 0, len(input))
+	offsets := make([]int, 0, len(input)+1)
+	buf = append(buf, input[:i]...)
+	for k := 0; k < i; k++ {
+		offsets = append(offsets, k)
+	}
+
+	for i < len(input) {
+		if input[i] < utf8.RuneSelf {
+			buf = append(buf, input[i])
+			offsets = append(offsets, i)
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(input[i:])
+		if c, ok := foldRune(r, mode); ok {
+			if c != 0 {
+				buf = append(buf, c)
+				offsets = append(offsets, i)
+			}
+		} else {
+			buf = append(buf, input[i:i+size]...)
+			for k := 0; k < size; k++ {
+				offsets = append(offsets, i+k)
+			}
+		}
+		i += size
+	}
+	offsets = append(offsets, len(input))
+
+	return &Normalized{Text: string(buf), input: input, mode: mode, offsets: offsets}
+}
+
+// Span maps the [start, end) offsets of a match in Text to the original
+// input. Characters dropped inside the match are part of the span; those
+// right after it are not.
+func (n *Normalized) Span(start, end int) (int, int) {
+	if n.offsets == nil {
+		return start, end
+	}
+	from, to := n.offsets[start], n.offsets[end]
+	if n.mode&NormalizeInvisible != 0 {
+		for to > from {
+			r, size := utf8.DecodeLastRuneInString(n.input[:to])
+			if !isInvisibleRune(r) {
+				break
+			}
+			to -= size
+		}
+	}
+	return from, to
+}
+
+// Changed reports whether Text differs from the input.
+func (n *Normalized) Changed() bool {
+	return n.offsets != nil
+}
+
+func needsNormalize(s string, mode NormalizeMode) bool {
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			continue
+		}
+		if _, ok := foldRune(r, mode); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// foldRune returns the ASCII equivalent of r under mode, if any; 0 means r
+// is dropped.
+func foldRune(r rune, mode NormalizeMode) (byte, bool) {
+	if mode&NormalizeInvisible != 0 && isInvisibleRune(r) {
+		return 0, true
+	}
+	if mode&NormalizeConfusables != 0 {
+		if c, ok := confusables[r]; ok {
+			return c, true
+		}
+	}
+	if mode&NormalizeEquivalents == 0 {
+		return 0, false
+	}
+
+	switch {
+	case r >= 0xFF01 && r <= 0xFF5E:
+		return byte(r - 0xFF01 + '!'), true
+	case r == 0x00A0, r >= 0x2000 && r <= 0x200A, r == 0x202F, r == 0x205F, r == 0x3000:
+		return ' ', true
+	case r >= 0x2010 && r <= 0x2015, r == 0x2212, r == 0xFE63:
+		return '-', true
+	case r == 0x3002, r == 0xFF61:
+		return '.', true
+	}
+	for _, zero := range unicodeDigitZeros {
+		if r >= zero && r <= zero+9 {
+			return byte('0' + r - zero), true
+		}
+	}
+	return 0, false
+}
+
+// isInvisibleRune reports zero-width and formatting characters that render
+// as nothing.
+func isInvisibleRune(r rune) bool {
+	switch {
+	case r == 0x00AD, r == 0x034F, r == 0x180E, r == 0xFEFF:
+		// soft hyphen, grapheme joiner, Mongolian vowel separator, BOM
+		return true
+	case r >= 0x200B && r <= 0x200F:
+		// zero-width space and joiners, LRM, RLM
+		return true
+	case r >= 0x202A && r <= 0x202E, r >= 0x2066 && r <= 0x2069:
+		// bidi embeddings, overrides and isolates
+		return true
+	case r >= 0x2060 && r <= 0x2064:
+		// word joiner, invisible operators
+		return true
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package detectors
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"ASCII Untouched", "CPF 111.444.777-35", "CPF 111.444.777-35"},
+		{"Full-Width Digits", "１１１.４４４.７７７-３５", "111.444.777-35"},
+		{"Full-Width Punctuation", "ｊｏｈｎ＠ｅｘａｍｐｌｅ．ｃｏｍ", "john@example.com"},
+		{"Arabic-Indic Digits", "٠١٢٣٤٥٦٧٨٩", "0123456789"},
+		{"Persian Digits", "۰۱۲۳۴۵۶۷۸۹", "0123456789"},
+		{"Devanagari Digits", "१२३", "123"},
+		{"NBSP And Thin Space", "+55\u00a011\u200999999\u20119999", "+55 11 99999-9999"},
+		{"Minus And Dashes", "111.444.777−35 a–b", "111.444.777-35 a-b"},
+		{"Other Runes Kept", "joão 🚀 例え", "joão 🚀 例え"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := Normalize(tt.input)
+			if n.Text != tt.expected {
+				t.Errorf("input: %q\nexpected %q, got %q", tt.input, tt.expected, n.Text)
+			}
+		})
+	}
+}
+
+func TestNormalize_Span(t *testing.T) {
+	input := "CPF: １１１.４４４.７７７-３５ ok"
+	n := Normalize(input)
+	if !n.Changed() {
+		t.Fatal("expected a changed text")
+	}
+
+	matches := NewCPFDetector().Scan(n.Text)
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 CPF in %q, got %d", n.Text, len(matches))
+	}
+	start, end := n.Span(matches[0].StartIndex, matches[0].EndIndex)
+	if got := input[start:end]; got != "１１１.４４４.７７７-３５" {
+		t.Errorf("unexpected original span %q", got)
+	}
+
+	if n := Normalize("plain ascii"); n.Changed() {
+		t.Error("ASCII input must not be copied")
+	}
+}
+
+func TestNormalizeWith_Evasion(t *testing.T) {
+	const evasion = NormalizeInvisible | NormalizeConfusables
+
+	tests := []struct {
+		name     string
+		input    string
+		mode     NormalizeMode
+		expected string
+	}{
+		{"Zero-Width Space", "4111\u200b1111\u200b1111\u200b1111", evasion, "4111111111111111"},
+		{"Soft Hyphen And Joiners", "jo\u00adhn@exa\u200dmple.com", evasion, "john@example.com"},
+		{"BOM And Bidi", "\ufeff111.444\u202e.777-35", evasion, "111.444.777-35"},
+		{"Cyrillic Look-Alikes", "john@ex\u0430mple.\u0441om", evasion, "john@example.com"},
+		{"Greek Look-Alikes", "\u039f\u039a", evasion, "OK"},
+		{"Invisible Only", "a\u200bb", NormalizeInvisible, "ab"},
+		{"Confusables Only", "\u0430\u200b", NormalizeConfusables, "a\u200b"},
+		{"Equivalents Untouched", "１\u200b２", evasion, "１２"},
+		{"All Modes", "１\u200b２\u0430", evasion | NormalizeEquivalents, "12a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NormalizeWith(tt.input, tt.mode)
+			if n.Text != tt.expected {
+				t.Errorf("input: %q\nexpected %q, got %q", tt.input, tt.expected, n.Text)
+			}
+		})
+	}
+}
+
+func TestNormalizeWith_EvasionSpan(t *testing.T) {
+	input := "pay with 4111\u200b1111\u200b1111\u200b1111\u200b now"
+	n := NormalizeWith(input, NormalizeInvisible|NormalizeConfusables)
+
+	matches := NewCreditCardDetector().Scan(n.Text)
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 card in %q, got %d", n.Text, len(matches))
+	}
+	start, end := n.Span(matches[0].StartIndex, matches[0].EndIndex)
+	if got := input[start:end]; got != "4111\u200b1111\u200b1111\u200b1111" {
+		t.Errorf("unexpected original span %q", got)
+	}
+}
+
+// Run with: go test -fuzz=FuzzNormalize -fuzztime=10s
+func FuzzNormalize(f *testing.F) {
+	f.Add("１１１.４４４.７７７-３５")
+	f.Add("joão ٣")
+
+	f.Add("4111\u200b1111 ex\u0430mple")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		n := NormalizeWith(orig, NormalizeEquivalents|NormalizeInvisible|NormalizeConfusables)
+		start, end := n.Span(0, len(n.Text))
+		if start > end || end > len(orig) {
+			t.Errorf("full span maps to [%d, %d) in %d bytes", start, end, len(orig))
+		}
+	})
+}
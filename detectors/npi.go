@@ -0,0 +1,72 @@
+package detectors
+
+// NPIDetector finds US National Provider Identifiers: 10 digits starting with
+// 1 or 2 whose Luhn check (over the "80840" prefix plus the number) is valid.
+// Ten digits are also a bare US phone number, so an "NPI" keyword must appear
+// shortly before.
+type NPIDetector struct{}
+
+func (d *NPIDetector) Name() string {
+	return "us_npi"
+}
+
+var npiKeywords = []string{"npi", "national provider"}
+
+// healthKeywordWindow is how far before a health identifier its keyword may
+// appear.
+const healthKeywordWindow = 32
+
+func (d *NPIDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NPIDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NPIDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [15]byte
+	copy(digits[:], "80840")
+
+	for i := 0; i+10 <= len(input); i++ {
+		if !isDigitChar(input[i]) || (i > 0 && isDigitChar(input[i-1])) {
+			continue
+		}
+
+		end := i
+		for end < len(input) && end-i < 11 && isDigitChar(input[end]) {
+			end++
+		}
+		if end-i != 10 || (end < len(input) && isAlnumChar(input[end])) {
+			i = end
+			continue
+		}
+		if input[i] != '1' && input[i] != '2' {
+			i = end
+			continue
+		}
+
+		copy(digits[5:], input[i:end])
+		if !isValidLuhnBytes(digits[:]) || keywordBefore(input, i, healthKeywordWindow, npiKeywords) == "" {
+			i = end
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeNPI,
+			Score:      1.0,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewNPIDetector() Detector {
+	return &NPIDetector{}
+}
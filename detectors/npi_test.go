@@ -0,0 +1,52 @@
+package detectors
+
+import "testing"
+
+func TestNPIDetector(t *testing.T) {
+	d := NewNPIDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Keyword", "NPI 1234567893", 1},
+		{"With Colon", "Provider NPI: 1245319599", 1},
+		{"Long Keyword", "National Provider Identifier 1234567893", 1},
+
+		// Context
+		{"No Keyword", "Call 1234567893 now", 0},
+
+		// Invalid Cases
+		{"Bad Check Digit", "NPI 1234567890", 0},
+		{"Wrong First Digit", "NPI 3234567893", 0},
+		{"Eleven Digits", "NPI 12345678931", 0},
+		{"Alnum Suffix", "NPI 1234567893A", 0},
+
+		// Noise & Boundary
+		{"Sentence End", "Prescriber NPI is 1234567893.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzNPI -fuzztime=10s
+func FuzzNPIDetector(f *testing.F) {
+	d := NewNPIDetector()
+
+	f.Add("NPI 1234567893")
+	f.Add("npi 1")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,88 @@
+package detectors
+
+type NRICDetector struct{}
+
+func (d *NRICDetector) Name() string {
+	return "sg_nric"
+}
+
+func (d *NRICDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *NRICDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *NRICDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+9 <= len(input); i++ {
+		switch input[i] {
+		case 'S', 'T', 'F', 'G', 'M':
+		default:
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		end := i + 9
+		if end < len(input) && isAlnumChar(input[end]) {
+			continue
+		}
+
+		if isValidNRIC(input[i:end]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeNRIC,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewNRICDetector detects Singapore NRIC and FIN numbers (S1234567D).
+func NewNRICDetector() Detector {
+	return &NRICDetector{}
+}
+
+// isValidNRIC checks the weighted Mod11 check letter. The prefix selects
+// the offset (T/G for 2000s issues, M for the 2022 FIN series) and the table
+// (S/T for citizens and PRs, F/G/M for foreigners).
+func isValidNRIC(s string) bool {
+	weights := [7]int{2, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for k := 0; k < 7; k++ {
+		c := s[k+1]
+		if !isDigitChar(c) {
+			return false
+		}
+		sum += int(c-'0') * weights[k]
+	}
+
+	var table string
+	switch s[0] {
+	case 'S':
+		table = "JZIHGFEDCBA"
+	case 'T':
+		sum += 4
+		table = "JZIHGFEDCBA"
+	case 'F':
+		table = "XWUTRQPNMLK"
+	case 'G':
+		sum += 4
+		table = "XWUTRQPNMLK"
+	case 'M':
+		sum += 3
+		table = "XWUTRQPNJLK"
+	}
+
+	return s[8] == table[sum%11]
+}
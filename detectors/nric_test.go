@@ -0,0 +1,51 @@
+package detectors
+
+import "testing"
+
+func TestNRICDetector(t *testing.T) {
+	d := NewNRICDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid S Series", "NRIC S1234567D on file.", 1},
+		{"Valid T Series", "NRIC: T0123456G", 1},
+		{"Valid F Series", "FIN F1234567N", 1},
+		{"Valid G Series", "FIN G1234567X", 1},
+		{"Valid M Series", "FIN M1234567K", 1},
+
+		// Near Misses
+		{"Wrong Check Letter", "NRIC S1234567A", 0},
+		{"Wrong Table", "FIN F1234567D", 0},
+		{"Unknown Prefix", "NRIC A1234567D", 0},
+		{"Six Digits", "NRIC S123456D", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "NRIC S1234567D 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzNRIC -fuzztime=10s
+func FuzzNRICDetector(f *testing.F) {
+	d := NewNRICDetector()
+
+	f.Add("S1234567D")
+	f.Add("M12")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
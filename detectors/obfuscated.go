@@ -0,0 +1,219 @@
+package detectors
+
+// Obfuscation-aware matchers used by EmailDetector and PhoneDetector when
+// their Obfuscated field is set.
+
+var (
+	obfuscatedAtWords  = []string{"at", "arroba"}
+	obfuscatedDotWords = []string{"dot", "ponto"}
+)
+
+// obfuscatedMarkerOpeners/Closers wrap a marker: [at], (dot), {at}, <at>.
+const (
+	obfuscatedMarkerOpeners = "[({<"
+	obfuscatedMarkerClosers = "])}>"
+)
+
+// matchObfuscatedMarker matches one of words at i, either wrapped in brackets
+// ("[at]", "(dot)", "[.]" when dot is set) with optional spaces around, or as
+// a bare word between spaces (" at "). It returns the end of the marker and
+// whether it was bracketed.
+func matchObfuscatedMarker(input string, i int, words []string, dot bool) (end int, bracketed, ok bool) {
+	j := skipDateSpaces(input, i)
+	if j >= len(input) {
+		return 0, false, false
+	}
+
+	if open := indexByte(obfuscatedMarkerOpeners, input[j]); open >= 0 {
+		k := skipDateSpaces(input, j+1)
+		w := matchMarkerWord(input, k, words)
+		if w == k && dot && k < len(input) && input[k] == '.' {
+			w = k + 1
+		}
+		if w == k {
+			return 0, false, false
+		}
+		k = skipDateSpaces(input, w)
+		if k >= len(input) || input[k] != obfuscatedMarkerClosers[open] {
+			return 0, false, false
+		}
+		return skipDateSpaces(input, k+1), true, true
+	}
+
+	// Bare words need a space on both sides
+	if j == i {
+		return 0, false, false
+	}
+	w := matchMarkerWord(input, j, words)
+	if w == j || w >= len(input) || input[w] != ' ' {
+		return 0, false, false
+	}
+	return skipDateSpaces(input, w), false, true
+}
+
+// matchMarkerWord matches one of words (case-insensitive) as a whole word at
+// i and returns its end, or i.
+func matchMarkerWord(input string, i int, words []string) int {
+	end := streetWordEnd(input, i)
+	if end > i && isWordFold(input[i:end], words) {
+		return end
+	}
+	return i
+}
+
+func indexByte(s string, c byte) int {
+	for k := 0; k < len(s); k++ {
+		if s[k] == c {
+			return k
+		}
+	}
+	return -1
+}
+
+// matchObfuscatedEmail matches "local<at>domain<dot>tld" where i is the first
+// byte after the local part. Markers may be bracketed ("[at]", "(dot)",
+// "[.]") or bare words (" at ", " dot ", " arroba ", " ponto "); literal
+// dots are allowed too. Bare " at " is common in prose ("meet at home",
+// "look at google.com"), so unless the at marker is bracketed, at least one
+// dot must be an obfuscated marker.
+func matchObfuscatedEmail(input string, i int) (start, end int, score float32, ok bool) {
+	start = i
+	for start > 0 && isEmailLocalChar(input[start-1]) {
+		start--
+	}
+	if start == i || input[start] == '.' || input[i-1] == '.' ||
+		(start > 0 && (isEmailUnsafeBoundary(input[start-1]) || input[start-1] >= 0x80)) {
+		return 0, 0, 0, false
+	}
+
+	j, bracketed, ok := matchObfuscatedMarker(input, i, obfuscatedAtWords, false)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	dots, markers := 0, 0
+	tld := 0
+	for {
+		// Domain label
+		k := j
+		letters := true
+		for k < len(input) && (isAlnumChar(input[k]) || input[k] == '-') {
+			if !isLetter(input[k]) {
+				letters = false
+			}
+			k++
+		}
+		if k == j || input[j] == '-' || input[k-1] == '-' {
+			return 0, 0, 0, false
+		}
+		end, tld = k, 0
+		if letters {
+			tld = k - j
+		}
+
+		// Next dot, literal or obfuscated
+		if k+1 < len(input) && input[k] == '.' && isAlnumChar(input[k+1]) {
+			j = k + 1
+			dots++
+			continue
+		}
+		if next, _, ok := matchObfuscatedMarker(input, k, obfuscatedDotWords, true); ok &&
+			next < len(input) && isAlnumChar(input[next]) {
+			j = next
+			dots++
+			markers++
+			continue
+		}
+		break
+	}
+
+	if dots == 0 || tld < 2 || (!bracketed && markers == 0) {
+		return 0, 0, 0, false
+	}
+	if end < len(input) && (isEmailUnsafeBoundary(input[end]) || input[end] >= 0x80) {
+		return 0, 0, 0, false
+	}
+
+	score = 0.8
+	if bracketed {
+		score = 0.9
+	}
+	return start, end, score, true
+}
+
+// spelledDigits are English and Portuguese digit words ("meia" is 6 in
+// Brazilian phone speech).
+var spelledDigits = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"um", "uma", "dois", "duas", "três", "tres", "quatro", "cinco", "seis", "meia",
+	"sete", "oito", "nove",
+}
+
+// spelledPhoneMinDigits and spelledPhoneMaxDigits bound a spelled-out number
+// (a local number without area code up to a full E.164 number).
+const (
+	spelledPhoneMinDigits = 8
+	spelledPhoneMaxDigits = 15
+)
+
+// matchSpelledPhone matches a phone number written with digit words ("nove
+// nove oito sete ...", "five five five 0 1 3 2") or as single digits spaced
+// out ("9 9 8 7 6 5 4 3"). Tokens are separated by spaces, hyphens or
+// commas. Plain digit groups ("2024 10 15") are left to the other detectors:
+// the run needs a digit word, or only single-digit tokens.
+func matchSpelledPhone(input string, i int) (int, bool) {
+	digits, words, groups := 0, 0, 0
+	end := i
+
+	for j := i; j < len(input); {
+		k, n, word := readSpelledToken(input, j)
+		if k == j {
+			break
+		}
+		digits += n
+		if word {
+			words++
+		} else if n > 1 {
+			groups++
+		}
+		if digits > spelledPhoneMaxDigits {
+			return 0, false
+		}
+		end = k
+
+		// Separator to the next token
+		j = k
+		for j < len(input) && j-k < 3 && (input[j] == ' ' || input[j] == '-' || input[j] == ',') {
+			j++
+		}
+		if j == k {
+			break
+		}
+	}
+
+	if digits < spelledPhoneMinDigits || (words == 0 && groups > 0) {
+		return 0, false
+	}
+	return end, true
+}
+
+// readSpelledToken reads a digit run or a digit word at i and returns its
+// end (i if there is none), how many digits it holds and whether it was a
+// word.
+func readSpelledToken(input string, i int) (end, digits int, word bool) {
+	if isDigitChar(input[i]) {
+		end = i
+		for end < len(input) && isDigitChar(input[end]) {
+			end++
+		}
+		if end < len(input) && (isLetter(input[end]) || input[end] >= 0x80) {
+			return i, 0, false
+		}
+		return end, end - i, false
+	}
+	end = streetWordEnd(input, i)
+	if end == i || !isWordFold(input[i:end], spelledDigits) {
+		return i, 0, false
+	}
+	return end, 1, true
+}
@@ -0,0 +1,77 @@
+package detectors
+
+type PANDetector struct{}
+
+func (d *PANDetector) Name() string {
+	return "in_pan"
+}
+
+func (d *PANDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *PANDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *PANDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+10 <= len(input); i++ {
+		if !isUpperLetter(input[i]) {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		end := i + 10
+		if end < len(input) && isAlnumChar(input[end]) {
+			continue
+		}
+
+		if isValidPAN(input[i:end]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypePAN,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewPANDetector detects Indian Permanent Account Numbers (ABCPE1234F).
+func NewPANDetector() Detector {
+	return &PANDetector{}
+}
+
+// isValidPAN checks the AAAAA9999A layout and the holder type in the fourth
+// letter. The final letter is a check character whose algorithm is not public.
+func isValidPAN(s string) bool {
+	for k := 0; k < 5; k++ {
+		if !isUpperLetter(s[k]) {
+			return false
+		}
+	}
+	for k := 5; k < 9; k++ {
+		if !isDigitChar(s[k]) {
+			return false
+		}
+	}
+	if !isUpperLetter(s[9]) {
+		return false
+	}
+
+	switch s[3] {
+	case 'A', 'B', 'C', 'F', 'G', 'H', 'J', 'L', 'P', 'T':
+		return true
+	default:
+		return false
+	}
+}
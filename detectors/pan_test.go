@@ -0,0 +1,48 @@
+package detectors
+
+import "testing"
+
+func TestPANDetector(t *testing.T) {
+	d := NewPANDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Individual", "PAN ABCPE1234F on file.", 1},
+		{"Valid Company", "PAN: AAACR5055K", 1},
+
+		// Near Misses
+		{"Invalid Holder Type", "PAN ABCXE1234F", 0},
+		{"Lowercase", "pan abcpe1234f", 0},
+		{"Digit Suffix", "PAN ABCPE12345", 0},
+		{"Embedded In Word", "XABCPE1234F", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "PAN ABCPE1234F 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzPAN -fuzztime=10s
+func FuzzPANDetector(f *testing.F) {
+	d := NewPANDetector()
+
+	f.Add("ABCPE1234F")
+	f.Add("ABCP")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,259 @@
+package detectors
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PatternDetector is a detector built from a regular expression, for
+// internal identifiers that do not deserve their own implementation
+// (ACC-2024-000123, employee numbers). Build it with NewPattern.
+type PatternDetector struct {
+	name     string
+	typ      PIIType
+	re       *regexp.Regexp
+	group    int
+	validate Validator
+	keywords []string
+	window   int
+	boundary Boundary
+	score    float32
+}
+
+// PatternOption configures a PatternDetector.
+type PatternOption func(*PatternDetector) error
+
+// Boundary tells what may surround a pattern match.
+type Boundary uint8
+
+const (
+	// BoundaryWord rejects matches touching a letter or digit (default).
+	BoundaryWord Boundary = iota
+	// BoundaryDigit only rejects matches touching a digit, like the
+	// built-in numeric detectors.
+	BoundaryDigit
+	// BoundarySpace requires whitespace or the start/end of the input.
+	BoundarySpace
+	// BoundaryNone accepts every match of the expression.
+	BoundaryNone
+)
+
+// DefaultPatternKeywordWindow is how far before a match its keyword may
+// appear, unless PatternKeywordWindow says otherwise.
+const DefaultPatternKeywordWindow = 32
+
+// NewPattern builds a detector reporting the matches of expr as typ.
+//
+//	acc, err := detectors.NewPattern("account_id", detectors.NewPIIType("account_id"),
+//		`ACC-\d{4}-(\d{6})`, detectors.PatternGroup(1))
+func NewPattern(name string, typ PIIType, expr string, opts ...PatternOption) (*PatternDetector, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("pattern %q: %w", name, err)
+	}
+	d := &PatternDetector{
+		name:   name,
+		typ:    typ,
+		re:     re,
+		window: DefaultPatternKeywordWindow,
+		score:  1.0,
+	}
+	for _, opt := range opts {
+		if err := opt(d); err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", name, err)
+		}
+	}
+	return d, nil
+}
+
+// MustPattern is like NewPattern but panics on error. It simplifies
+// package-level detector variables.
+func MustPattern(name string, typ PIIType, expr string, opts ...PatternOption) *PatternDetector {
+	d, err := NewPattern(name, typ, expr, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// PatternGroup reports the given capture group instead of the whole match.
+func PatternGroup(group int) PatternOption {
+	return func(d *PatternDetector) error {
+		if group < 0 || group > d.re.NumSubexp() {
+			return fmt.Errorf("no capture group %d", group)
+		}
+		d.group = group
+		return nil
+	}
+}
+
+// PatternGroupName reports the named capture group (?P<name>...).
+func PatternGroupName(group string) PatternOption {
+	return func(d *PatternDetector) error {
+		i := d.re.SubexpIndex(group)
+		if i < 0 {
+			return fmt.Errorf("no capture group named %q", group)
+		}
+		d.group = i
+		return nil
+	}
+}
+
+// PatternValidate drops matches that fail v, e.g. ValidateLuhn or a custom
+// func.
+func PatternValidate(v Validator) PatternOption {
+	return func(d *PatternDetector) error {
+		d.validate = v
+		return nil
+	}
+}
+
+// PatternValidator uses a validator by name: "luhn", "mod11", "mod97",
+// "verhoeff" or one added with RegisterValidator.
+func PatternValidator(name string) PatternOption {
+	return func(d *PatternDetector) error {
+		v, ok := LookupValidator(name)
+		if !ok {
+			return fmt.Errorf("unknown validator %q", name)
+		}
+		d.validate = v
+		return nil
+	}
+}
+
+// PatternKeywords requires one of keywords (case-insensitive) shortly
+// before each match.
+func PatternKeywords(keywords ...string) PatternOption {
+	return func(d *PatternDetector) error {
+		for _, kw := range keywords {
+			d.keywords = append(d.keywords, strings.ToLower(kw))
+		}
+		return nil
+	}
+}
+
+// PatternKeywordWindow sets how many bytes before a match are searched for
+// keywords.
+func PatternKeywordWindow(window int) PatternOption {
+	return func(d *PatternDetector) error {
+		if window <= 0 {
+			return fmt.Errorf("keyword window must be positive, got %d", window)
+		}
+		d.window = window
+		return nil
+	}
+}
+
+// PatternBoundary sets what may surround a match (default BoundaryWord).
+func PatternBoundary(b Boundary) PatternOption {
+	return func(d *PatternDetector) error {
+		if b > BoundaryNone {
+			return fmt.Errorf("unknown boundary %d", b)
+		}
+		d.boundary = b
+		return nil
+	}
+}
+
+// PatternScore sets the score of every match (default 1.0).
+func PatternScore(score float32) PatternOption {
+	return func(d *PatternDetector) error {
+		if score <= 0 || score > 1 {
+			return fmt.Errorf("score must be in (0, 1], got %v", score)
+		}
+		d.score = score
+		return nil
+	}
+}
+
+func (d *PatternDetector) Name() string {
+	return d.name
+}
+
+// Type returns the PIIType reported by the detector.
+func (d *PatternDetector) Type() PIIType {
+	return d.typ
+}
+
+func (d *PatternDetector) Scan(input string) []Match {
+	var results []Match
+
+	for _, loc := range d.re.FindAllStringSubmatchIndex(input, -1) {
+		// Boundaries apply to the whole match, the group is what gets masked
+		start, end := loc[2*d.group], loc[2*d.group+1]
+		if start < 0 || start == end || !d.boundary.allows(input, loc[0], loc[1]) {
+			continue
+		}
+		if d.validate != nil && !d.validate(input[start:end]) {
+			continue
+		}
+		if len(d.keywords) > 0 && keywordBefore(input, start, d.window, d.keywords) == "" {
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: start,
+			EndIndex:   end,
+			Value:      input[start:end],
+			Type:       d.typ,
+			Score:      d.score,
+		})
+	}
+
+	return results
+}
+
+// allows checks the runes right before start and at end.
+func (b Boundary) allows(input string, start, end int) bool {
+	if b == BoundaryNone {
+		return true
+	}
+	before, after := utf8.RuneError, utf8.RuneError
+	if start > 0 {
+		before, _ = utf8.DecodeLastRuneInString(input[:start])
+	}
+	if end < len(input) {
+		after, _ = utf8.DecodeRuneInString(input[end:])
+	}
+	return b.allowsRune(before, start == 0) && b.allowsRune(after, end == len(input))
+}
+
+func (b Boundary) allowsRune(r rune, edge bool) bool {
+	if edge {
+		return true
+	}
+	switch b {
+	case BoundaryDigit:
+		return !unicode.IsDigit(r)
+	case BoundarySpace:
+		return unicode.IsSpace(r)
+	}
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// NewPIIType turns a name such as "employee id" into a token-safe PIIType
+// ("EMPLOYEE_ID"): ASCII letters are uppercased, digits kept and any other
+// run of characters becomes a single '_'. An empty result is TypeCustom.
+func NewPIIType(name string) PIIType {
+	var sb strings.Builder
+	sep := false
+	for i := 0; i < len(name); i++ {
+		c := upperASCII(name[i])
+		if isUpperLetter(c) || isDigitChar(c) {
+			if sep && sb.Len() > 0 {
+				sb.WriteByte('_')
+			}
+			sb.WriteByte(c)
+			sep = false
+			continue
+		}
+		sep = true
+	}
+	if sb.Len() == 0 {
+		return TypeCustom
+	}
+	return PIIType(sb.String())
+}
@@ -0,0 +1,138 @@
+package detectors
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPatternDetector(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		opts     []PatternOption
+		input    string
+		expected []string
+	}{
+		{"Whole Match", `ACC-\d{4}-\d{6}`, nil,
+			"see ACC-2024-000123 now", []string{"ACC-2024-000123"}},
+		{"Capture Group", `ACC-\d{4}-(\d{6})`, []PatternOption{PatternGroup(1)},
+			"see ACC-2024-000123 now", []string{"000123"}},
+		{"Named Group", `EMP(?P<id>\d{5})`, []PatternOption{PatternGroupName("id")},
+			"badge EMP12345", []string{"12345"}},
+		{"Validator", `\d{6,7}`, []PatternOption{PatternValidator("mod11")},
+			"codes 123455 and 123456", []string{"123455"}},
+		{"Custom Validator", `[A-Z]{3}\d{3}`, []PatternOption{PatternValidate(func(v string) bool { return v[0] == 'X' })},
+			"XAB123 YAB123", []string{"XAB123"}},
+		{"Keyword Required", `\d{5}`, []PatternOption{PatternKeywords("Employee")},
+			"employee 12345; the unrelated order number 54321", []string{"12345"}},
+		{"Keyword Window", `\d{5}`, []PatternOption{PatternKeywords("employee"), PatternKeywordWindow(5)},
+			"employee number is 12345", nil},
+		{"Word Boundary Default", `\d{5}`, nil,
+			"A12345 12345 123456", []string{"12345"}},
+		{"Word Boundary Unicode", `\d{5}`, nil,
+			"é12345 ok", nil},
+		{"Digit Boundary", `\d{5}`, []PatternOption{PatternBoundary(BoundaryDigit)},
+			"A12345 123456", []string{"12345"}},
+		{"Space Boundary", `\d{5}`, []PatternOption{PatternBoundary(BoundarySpace)},
+			"(12345) 12345", []string{"12345"}},
+		{"No Boundary", `\d{5}`, []PatternOption{PatternBoundary(BoundaryNone)},
+			"A12345", []string{"12345"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewPattern("test", NewPIIType("test"), tt.expr, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			matches := d.Scan(tt.input)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Value)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestPatternDetector_TypeAndScore(t *testing.T) {
+	d := MustPattern("employee_id", NewPIIType("employee id"), `EMP\d{5}`, PatternScore(0.7))
+
+	m := d.Scan("EMP12345")
+	if len(m) != 1 || m[0].Type != "EMPLOYEE_ID" || m[0].Score != 0.7 {
+		t.Errorf("unexpected matches %+v", m)
+	}
+	if d.Name() != "employee_id" || d.Type() != "EMPLOYEE_ID" {
+		t.Errorf("unexpected name %q / type %q", d.Name(), d.Type())
+	}
+}
+
+func TestPatternDetector_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		opt  PatternOption
+		want string
+	}{
+		{"Bad Regexp", `(`, nil, "missing closing )"},
+		{"Bad Group", `(\d)`, PatternGroup(2), "no capture group 2"},
+		{"Bad Group Name", `(\d)`, PatternGroupName("id"), `no capture group named "id"`},
+		{"Unknown Validator", `\d`, PatternValidator("crc"), `unknown validator "crc"`},
+		{"Bad Score", `\d`, PatternScore(2), "score must be in"},
+		{"Bad Window", `\d`, PatternKeywordWindow(0), "keyword window"},
+		{"Bad Boundary", `\d`, PatternBoundary(42), "unknown boundary"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []PatternOption
+			if tt.opt != nil {
+				opts = append(opts, tt.opt)
+			}
+			_, err := NewPattern("bad", TypeCustom, tt.expr, opts...)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestNewPIIType(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected PIIType
+	}{
+		{"employee id", "EMPLOYEE_ID"},
+		{"Account-Number", "ACCOUNT_NUMBER"},
+		{"  acc__id  ", "ACC_ID"},
+		{"ticket2", "TICKET2"},
+		{"", TypeCustom},
+		{"<<>>", TypeCustom},
+	}
+
+	for _, tt := range tests {
+		if got := NewPIIType(tt.input); got != tt.expected {
+			t.Errorf("NewPIIType(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+// Run with: go test -fuzz=FuzzPatternDetector -fuzztime=10s
+func FuzzPatternDetector(f *testing.F) {
+	d := MustPattern("acc", TypeCustom, `ACC-\d{4}-(\d{6})`, PatternGroup(1), PatternKeywords("conta"))
+
+	f.Add("conta ACC-2024-000123")
+	f.Add("ACC-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
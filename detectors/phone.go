@@ -1,62 +1,56 @@
 package detectors
 
-type PhoneDetector struct{}
+// PhoneDetector finds E.164 phone numbers (+ followed by 7-15 digits). With
+// Obfuscated it also finds numbers spelled out with digit words in English
+// or Portuguese ("nove nove oito ...") or spaced one digit at a time.
+type PhoneDetector struct {
+	Obfuscated bool
+}
 
 func (d *PhoneDetector) Name() string {
 	return "global_phone_e164"
 }
 
 func (d *PhoneDetector) Scan(input string) []Match {
-	var results []Match
-
-	for i := 0; i < len(input); i++ {
-		if input[i] != '+' {
-			continue
-		}
+	return d.scan(nil, input)
+}
 
-		start := i
-		digits := 0
-		hasInvalidSeparator := false
-		j := i + 1
+// ScanAppend implements AppendScanner.
+func (d *PhoneDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
 
-		for j < len(input) {
-			c := input[j]
+func (d *PhoneDetector) scan(dst []Match, input string) []Match {
+	results := dst
 
-			switch {
-			case c >= '0' && c <= '9':
-				digits++
-				if digits > 15 {
-					j++
-					goto nextCandidate
-				}
-			case isPhoneSeparator(c):
-				// separators are allowed only after the first digit
-				if digits == 0 {
-					hasInvalidSeparator = true
+	for i := 0; i < len(input); i++ {
+		if input[i] != '+' {
+			if d.Obfuscated && (i == 0 || !(isAlnumChar(input[i-1]) || input[i-1] >= 0x80)) {
+				if end, ok := matchSpelledPhone(input, i); ok {
+					results = append(results, Match{
+						StartIndex: i,
+						EndIndex:   end,
+						Value:      input[i:end],
+						Type:       TypePhone,
+						Score:      0.8,
+					})
+					i = end - 1
 				}
-			default:
-				goto boundaryCheck
 			}
-			j++
-		}
-
-	boundaryCheck:
-		// ensure the match is bounded (next char can't be digit)
-		if j < len(input) && input[j] >= '0' && input[j] <= '9' {
 			continue
 		}
 
-		if digits >= 7 && digits <= 15 && !hasInvalidSeparator {
+		end, ok, next := matchPhoneAt(input, i)
+		if ok {
 			results = append(results, Match{
-				StartIndex: start,
-				EndIndex:   j,
-				Value:      input[start:j],
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
 				Type:       TypePhone,
 				Score:      1.0,
 			})
 		}
-	nextCandidate:
-		i = j - 1
+		i = next - 1
 	}
 
 	return results
@@ -66,6 +60,45 @@ func NewPhoneDetector() Detector {
 	return &PhoneDetector{}
 }
 
+// matchPhoneAt looks for an international number at the '+' in input[i]
+// and returns its end, whether it is one, and where the scan resumes.
+func matchPhoneAt(input string, i int) (end int, ok bool, next int) {
+	digits := 0
+	hasInvalidSeparator := false
+	j := i + 1
+
+	for j < len(input) {
+		c := input[j]
+
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+			if digits > 15 {
+				return 0, false, j + 1
+			}
+		case isPhoneSeparator(c):
+			// separators are allowed only after the first digit
+			if digits == 0 {
+				hasInvalidSeparator = true
+			}
+		default:
+			goto boundaryCheck
+		}
+		j++
+	}
+
+boundaryCheck:
+	// ensure the match is bounded (next char can't be digit)
+	if j < len(input) && input[j] >= '0' && input[j] <= '9' {
+		return 0, false, i + 1
+	}
+
+	if digits >= 7 && digits <= 15 && !hasInvalidSeparator {
+		return j, true, j
+	}
+	return 0, false, j
+}
+
 func isPhoneSeparator(b byte) bool {
 	return b == ' ' || b == '-' || b == '.'
 }
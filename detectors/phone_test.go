@@ -48,6 +48,52 @@ func TestPhoneDetector(t *testing.T) {
 	}
 }
 
+func TestPhoneDetector_Obfuscated(t *testing.T) {
+	d := &PhoneDetector{Obfuscated: true}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Valid Cases
+		{"Portuguese Words", "liga nove nove oito sete seis cinco quatro três", []string{"nove nove oito sete seis cinco quatro três"}},
+		{"English Words", "call five five five zero one three two seven now", []string{"five five five zero one three two seven"}},
+		{"Mixed Digits And Words", "whats 11 nove 8765-4321", []string{"11 nove 8765-4321"}},
+		{"Spaced Digits", "zap 9 9 8 7 6 5 4 3 2", []string{"9 9 8 7 6 5 4 3 2"}},
+		{"Meia For Six", "fone: meia meia dois um, três quatro cinco seis", []string{"meia meia dois um, três quatro cinco seis"}},
+		{"E164 Still Found", "+55 11 99999-9999", []string{"+55 11 99999-9999"}},
+
+		// False Positives
+		{"Counting Short", "one two three, go!", nil},
+		{"Digit Groups", "on 2024 10 15 at 10 30", nil},
+		{"Prose", "I have two cats and one dog", nil},
+		{"Word Prefix", "someone nonetheless", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Value)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("input: %q\nexpected %v, got %v", tt.input, tt.expected, got)
+				}
+			}
+		})
+	}
+
+	if m := NewPhoneDetector().Scan("nove nove oito sete seis cinco quatro três"); len(m) != 0 {
+		t.Errorf("spelled-out numbers must be opt-in, got %v", m)
+	}
+}
+
 // 2. Concurrency Test (Thread-Safety)
 func TestPhoneDetector_Concurrency(t *testing.T) {
 	d := NewPhoneDetector()
@@ -72,15 +118,18 @@ func TestPhoneDetector_Concurrency(t *testing.T) {
 // Run with: go test -fuzz=FuzzPhone -fuzztime=10s
 func FuzzPhoneDetector(f *testing.F) {
 	d := NewPhoneDetector()
+	obfuscated := &PhoneDetector{Obfuscated: true}
 
 	// Seed corpus
 	f.Add("+1 555 010 9999")
 	f.Add("+55 11 99999-9999")
 	f.Add("random text +123")
+	f.Add("nove nove 8 sete, meia")
 
 	f.Fuzz(func(t *testing.T, orig string) {
 		// MUST NOT PANIC
 		_ = d.Scan(orig)
+		_ = obfuscated.Scan(orig)
 	})
 }
 
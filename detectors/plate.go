@@ -0,0 +1,106 @@
+package detectors
+
+// LicensePlateDetector finds Brazilian license plates: the Mercosul layout
+// (ABC1D23) and the legacy one (ABC-1234). Plates must be uppercase. A legacy
+// plate written without the hyphen (ABC1234) looks like any product code, so
+// it needs a keyword such as "placa" or "plate" in front; the keyword also
+// allows lowercase plates.
+type LicensePlateDetector struct{}
+
+func (d *LicensePlateDetector) Name() string {
+	return "br_license_plate"
+}
+
+var plateKeywords = []string{"placa", "plate", "veículo", "veiculo", "vehicle", "carro"}
+
+// plateKeywordWindow is how far before a plate the keyword may appear.
+const plateKeywordWindow = 24
+
+func (d *LicensePlateDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *LicensePlateDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *LicensePlateDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+7 <= len(input); i++ {
+		if !isLetter(input[i]) || (i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '-')) {
+			continue
+		}
+
+		end, hyphen, mercosul, upper, ok := readPlate(input, i)
+		if !ok {
+			continue
+		}
+
+		keyword := keywordBefore(input, i, plateKeywordWindow, plateKeywords) != ""
+		var score float32
+		switch {
+		case keyword:
+			score = 1.0
+		case !upper:
+			continue
+		case mercosul:
+			score = 0.9
+		case hyphen:
+			score = 0.7
+		default:
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeLicensePlate,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewLicensePlateDetector() Detector {
+	return &LicensePlateDetector{}
+}
+
+// readPlate reads LLL[-]DLDD (Mercosul) or LLL[-]DDDD (legacy) at i.
+func readPlate(input string, i int) (end int, hyphen, mercosul, upper, ok bool) {
+	upper = true
+	j := i
+	for ; j < i+3; j++ {
+		if !isLetter(input[j]) {
+			return 0, false, false, false, false
+		}
+		upper = upper && isUpperLetter(input[j])
+	}
+	if input[j] == '-' {
+		hyphen = true
+		j++
+	}
+	if j+4 > len(input) {
+		return 0, false, false, false, false
+	}
+
+	for k := 0; k < 4; k, j = k+1, j+1 {
+		c := input[j]
+		switch {
+		case isDigitChar(c):
+		case k == 1 && isLetter(c):
+			mercosul = true
+			upper = upper && isUpperLetter(c)
+		default:
+			return 0, false, false, false, false
+		}
+	}
+	if j < len(input) && (isAlnumChar(input[j]) || (input[j] == '-' && j+1 < len(input) && isAlnumChar(input[j+1]))) {
+		return 0, false, false, false, false
+	}
+	return j, hyphen, mercosul, upper, true
+}
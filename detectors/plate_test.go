@@ -0,0 +1,57 @@
+package detectors
+
+import "testing"
+
+func TestLicensePlateDetector(t *testing.T) {
+	d := NewLicensePlateDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Mercosul", "Veículo ABC1D23 envolvido no sinistro", 1},
+		{"Mercosul Hyphen", "Colisão com BRA-2E19", 1},
+		{"Legacy Hyphen", "Carro de placa ABC-1234", 1},
+		{"Legacy Hyphen No Keyword", "O segurado dirigia o ABC-1234 quando", 1},
+		{"Legacy Bare With Keyword", "Placa: ABC1234", 1},
+		{"Lowercase With Keyword", "placa abc1d23", 1},
+
+		// Context
+		{"Legacy Bare Without Keyword", "SKU ABC1234 in stock", 0},
+		{"Lowercase Without Keyword", "code abc1d23", 0},
+
+		// Invalid Cases
+		{"Four Letters", "Ticket JIRA-1234", 0},
+		{"Five Digits", "ABC-12345", 0},
+		{"Letter In Wrong Place", "ABC12D3", 0},
+		{"Glued", "XABC1D23", 0},
+
+		// Noise & Boundary
+		{"Sentence End", "Placa do terceiro: ABC1D23.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzLicensePlate -fuzztime=10s
+func FuzzLicensePlateDetector(f *testing.F) {
+	d := NewLicensePlateDetector()
+
+	f.Add("ABC1D23")
+	f.Add("placa abc-1234")
+	f.Add("ABC-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,87 @@
+package detectors
+
+// RENAVAMDetector finds Brazilian vehicle registry numbers (RENAVAM): 11
+// digits (or the legacy 9, left-padded with zeros) with a Mod11 check digit.
+// An 11-digit number could just as well be a CPF or a phone number, so the
+// "RENAVAM" keyword must appear shortly before it.
+type RENAVAMDetector struct{}
+
+func (d *RENAVAMDetector) Name() string {
+	return "br_renavam"
+}
+
+var renavamKeywords = []string{"renavam"}
+
+// renavamWeights apply to the first 10 digits.
+var renavamWeights = [10]int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+func (d *RENAVAMDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *RENAVAMDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *RENAVAMDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [11]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) || (i > 0 && isDigitChar(input[i-1])) {
+			continue
+		}
+
+		end := i
+		for end < len(input) && end-i < 12 && isDigitChar(input[end]) {
+			end++
+		}
+		n := end - i
+		if n != 9 && n != 11 {
+			i = end
+			continue
+		}
+		if end < len(input) && isAlnumChar(input[end]) {
+			i = end
+			continue
+		}
+
+		// Legacy 9-digit numbers are left-padded to 11
+		copy(digits[:], "00")
+		copy(digits[11-n:], input[i:end])
+		if !isValidRENAVAMBytes(digits[:]) ||
+			keywordBefore(input, i, plateKeywordWindow, renavamKeywords) == "" {
+			i = end
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeRENAVAM,
+			Score:      1.0,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewRENAVAMDetector() Detector {
+	return &RENAVAMDetector{}
+}
+
+// isValidRENAVAMBytes validates the Mod11 check digit of 11 digits.
+func isValidRENAVAMBytes(b []byte) bool {
+	sum := 0
+	for k := 0; k < 10; k++ {
+		sum += int(b[k]-'0') * renavamWeights[k]
+	}
+	check := sum * 10 % 11
+	if check == 10 {
+		check = 0
+	}
+	return int(b[10]-'0') == check
+}
@@ -0,0 +1,51 @@
+package detectors
+
+import "testing"
+
+func TestRENAVAMDetector(t *testing.T) {
+	d := NewRENAVAMDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Eleven Digits", "RENAVAM 63920438847", 1},
+		{"With Colon", "Renavam: 12345678900", 1},
+		{"Legacy Nine Digits", "renavam 123456789", 1},
+
+		// Context
+		{"No Keyword", "Protocolo 63920438847", 0},
+
+		// Invalid Cases
+		{"Bad Check Digit", "RENAVAM 63920438840", 0},
+		{"Ten Digits", "RENAVAM 6392043884", 0},
+		{"Twelve Digits", "RENAVAM 639204388470", 0},
+
+		// Noise & Boundary
+		{"Sentence End", "O RENAVAM é 63920438847.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzRENAVAM -fuzztime=10s
+func FuzzRENAVAMDetector(f *testing.F) {
+	d := NewRENAVAMDetector()
+
+	f.Add("RENAVAM 63920438847")
+	f.Add("renavam 1")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,145 @@
+package detectors
+
+type RFCDetector struct{}
+
+func (d *RFCDetector) Name() string {
+	return "mx_rfc"
+}
+
+func (d *RFCDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *RFCDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *RFCDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var buf [13]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isRFCLetter(input[i]) {
+			continue
+		}
+		if i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '&') {
+			continue
+		}
+
+		// Prefix: 3 letters for companies, 4 for individuals
+		j := i
+		for j < len(input) && isRFCLetter(input[j]) {
+			j++
+		}
+		prefix := j - i
+		if prefix != 3 && prefix != 4 {
+			i = j - 1
+			continue
+		}
+		n := copy(buf[:], input[i:j])
+
+		if end, ok := readRFCTail(input, j, buf[n:n+9]); ok {
+			if isValidRFCBytes(buf[:n+9]) {
+				results = append(results, Match{
+					StartIndex: i,
+					EndIndex:   end,
+					Value:      input[i:end],
+					Type:       TypeRFC,
+					Score:      1.0,
+				})
+				i = end - 1
+				continue
+			}
+		}
+		i = j - 1
+	}
+
+	return results
+}
+
+// NewRFCDetector detects Mexican RFC tax IDs (GODE561231GR8, ABC680524P73).
+func NewRFCDetector() Detector {
+	return &RFCDetector{}
+}
+
+// readRFCTail reads the YYMMDD date and the 3-char homoclave that follow the
+// letter prefix, optionally separated by a single '-' or ' '.
+func readRFCTail(input string, j int, out []byte) (int, bool) {
+	if j < len(input) && (input[j] == '-' || input[j] == ' ') {
+		j++
+	}
+	for k := 0; k < 6; k++ {
+		if j >= len(input) || !isDigitChar(input[j]) {
+			return 0, false
+		}
+		out[k] = input[j]
+		j++
+	}
+	if j < len(input) && (input[j] == '-' || input[j] == ' ') {
+		j++
+	}
+	for k := 6; k < 9; k++ {
+		if j >= len(input) || !(isUpperLetter(input[j]) || isDigitChar(input[j])) {
+			return 0, false
+		}
+		out[k] = input[j]
+		j++
+	}
+	if j < len(input) && (isAlnumChar(input[j]) || input[j] == '&') {
+		return 0, false
+	}
+	return j, true
+}
+
+func isRFCLetter(b byte) bool {
+	return isUpperLetter(b) || b == '&'
+}
+
+// rfcCharValue maps a character to its value in the SAT check digit alphabet
+// "0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ".
+func rfcCharValue(b byte) int {
+	switch {
+	case isDigitChar(b):
+		return int(b - '0')
+	case b >= 'A' && b <= 'N':
+		return int(b-'A') + 10
+	case b == '&':
+		return 24
+	case b >= 'O' && b <= 'Z':
+		return int(b-'O') + 25
+	default: // padding space for 12-char company RFCs
+		return 37
+	}
+}
+
+// isValidRFCBytes validates the embedded date and the Mod11 check character.
+// rfc holds 12 (company) or 13 (individual) characters.
+func isValidRFCBytes(rfc []byte) bool {
+	prefix := len(rfc) - 9
+	if !isValidYYMMDD(rfc[prefix : prefix+6]) {
+		return false
+	}
+
+	// Company RFCs are left-padded with a space so both forms weigh 12 chars.
+	sum := 0
+	weight := 13
+	if prefix == 3 {
+		sum += rfcCharValue(' ') * weight
+		weight--
+	}
+	for i := 0; i < len(rfc)-1; i++ {
+		sum += rfcCharValue(rfc[i]) * weight
+		weight--
+	}
+
+	check := rfc[len(rfc)-1]
+	switch digit := 11 - sum%11; digit {
+	case 11:
+		return check == '0'
+	case 10:
+		return check == 'A'
+	default:
+		return int(check) == '0'+digit
+	}
+}
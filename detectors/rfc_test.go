@@ -0,0 +1,53 @@
+package detectors
+
+import "testing"
+
+func TestRFCDetector(t *testing.T) {
+	d := NewRFCDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Individual", "RFC: GODE561231GR8 vigente.", 1},
+		{"Valid Company", "RFC ABC680524P73", 1},
+		{"Valid Dashed", "RFC GODE-561231-GR8", 1},
+		{"Valid Spaced", "RFC GODE 561231 GR8", 1},
+
+		// Invalid Cases
+		{"Invalid Checksum", "RFC GODE561231GR9", 0},
+		{"Invalid Date", "RFC GODE561331GR8", 0},
+		{"Lowercase", "rfc gode561231gr8", 0},
+		{"Five Letter Prefix", "RFC XGODE561231GR8", 0},
+		{"Embedded In Word", "RFC GODE561231GR8X", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "RFC GODE561231GR8 🚀", 1},
+		{"Sentence End", "Mi RFC es GODE561231GR8.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzRFC -fuzztime=10s
+func FuzzRFCDetector(f *testing.F) {
+	d := NewRFCDetector()
+
+	f.Add("GODE561231GR8")
+	f.Add("ABC 680524 P73")
+	f.Add("&&&-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
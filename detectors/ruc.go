@@ -0,0 +1,102 @@
+package detectors
+
+type RUCDetector struct{}
+
+func (d *RUCDetector) Name() string {
+	return "pe_ruc"
+}
+
+func (d *RUCDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *RUCDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *RUCDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [11]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		start := i
+		count := 0
+		j := i
+
+		for j < len(input) && count < 11 {
+			c := input[j]
+			switch {
+			case isDigitChar(c):
+				digits[count] = c
+				count++
+			case c == '-' || c == ' ':
+				if !validRUCSeparatorPosition(count) {
+					goto nextCandidate
+				}
+			default:
+				goto evaluate
+			}
+			j++
+		}
+
+	evaluate:
+		if count == 11 {
+			if j < len(input) && isDigitChar(input[j]) {
+				goto nextCandidate
+			}
+			if isValidRUCBytes(digits[:]) {
+				results = append(results, Match{
+					StartIndex: start,
+					EndIndex:   j,
+					Value:      input[start:j],
+					Type:       TypeRUC,
+					Score:      1.0,
+				})
+				i = j - 1
+				continue
+			}
+		}
+
+	nextCandidate:
+	}
+
+	return results
+}
+
+// NewRUCDetector detects Peruvian RUC numbers (11 digits, e.g. 20100123453).
+func NewRUCDetector() Detector {
+	return &RUCDetector{}
+}
+
+// RUCs are usually written plain; SUNAT forms sometimes split the
+// taxpayer type (20-10012345-3) or isolate the check digit (2010012345-3).
+func validRUCSeparatorPosition(count int) bool {
+	return count == 2 || count == 10
+}
+
+// isValidRUCBytes checks the taxpayer type prefix and the Mod11 check digit.
+func isValidRUCBytes(ruc []byte) bool {
+	switch int(ruc[0]-'0')*10 + int(ruc[1]-'0') {
+	case 10, 15, 16, 17, 20:
+	default:
+		return false
+	}
+
+	weights := [10]int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		sum += int(ruc[i]-'0') * weights[i]
+	}
+
+	digit := (11 - sum%11) % 10
+
+	return int(ruc[10]-'0') == digit
+}
@@ -0,0 +1,52 @@
+package detectors
+
+import "testing"
+
+func TestRUCDetector(t *testing.T) {
+	d := NewRUCDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Company", "RUC 20100123453 activo.", 1},
+		{"Valid Person", "RUC: 10461234564", 1},
+		{"Valid Split Type", "RUC 20-10012345-3", 1},
+		{"Valid Split Digit", "RUC 2010012345-3", 1},
+
+		// Invalid Cases
+		{"Invalid Checksum", "RUC 20100123454", 0},
+		{"Unknown Prefix", "RUC 30100123453", 0},
+		{"Too Long", "RUC 201001234530", 0},
+		{"Wrong Separator Position", "RUC 201-0012345-3", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "RUC 20100123453 🚀", 1},
+		{"Boundary End", "El RUC es 20100123453", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzRUC -fuzztime=10s
+func FuzzRUCDetector(f *testing.F) {
+	d := NewRUCDetector()
+
+	f.Add("20100123453")
+	f.Add("20-10012345-3")
+	f.Add("Random text 10")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,94 @@
+package detectors
+
+type RUTDetector struct{}
+
+func (d *RUTDetector) Name() string {
+	return "cl_rut"
+}
+
+func (d *RUTDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *RUTDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *RUTDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var body [8]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '.') {
+			continue
+		}
+
+		count, j, ok := readGroupedDigits(input, i, body[:])
+		if !ok {
+			continue
+		}
+		if count < 7 {
+			i = j
+			continue
+		}
+
+		// The check digit is always introduced by a dash: 12.345.678-5
+		if j+1 >= len(input) || input[j] != '-' {
+			i = j
+			continue
+		}
+		dv := input[j+1]
+		end := j + 2
+		if end < len(input) && isAlnumChar(input[end]) {
+			i = j
+			continue
+		}
+
+		if isValidRUTBytes(body[:count], dv) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeRUT,
+				Score:      1.0,
+			})
+			i = end - 1
+			continue
+		}
+		i = j
+	}
+
+	return results
+}
+
+// NewRUTDetector detects Chilean RUT/RUN numbers (12.345.678-5, 7654321-K).
+func NewRUTDetector() Detector {
+	return &RUTDetector{}
+}
+
+// isValidRUTBytes implements the Mod11 check with cycling weights 2..7,
+// where a result of 10 is written as 'K'.
+func isValidRUTBytes(body []byte, dv byte) bool {
+	sum := 0
+	weight := 2
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += int(body[i]-'0') * weight
+		weight++
+		if weight > 7 {
+			weight = 2
+		}
+	}
+
+	switch digit := 11 - sum%11; digit {
+	case 11:
+		return dv == '0'
+	case 10:
+		return dv == 'K' || dv == 'k'
+	default:
+		return int(dv) == '0'+digit
+	}
+}
@@ -0,0 +1,54 @@
+package detectors
+
+import "testing"
+
+func TestRUTDetector(t *testing.T) {
+	d := NewRUTDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Formatted", "RUT: 12.345.678-5 vigente.", 1},
+		{"Valid Seven Digits", "RUN 7.654.321-6", 1},
+		{"Valid K Upper", "RUT 10.000.013-K", 1},
+		{"Valid K Lower", "RUT 10000013-k", 1},
+		{"Valid Undotted", "RUT 11111111-1", 1},
+
+		// Invalid Cases
+		{"Invalid Checksum", "RUT 12.345.678-4", 0},
+		{"Missing Dash", "RUT 123456785", 0},
+		{"Bad Dot Grouping", "RUT 1.2345.678-5", 0},
+		{"Too Short", "RUT 345.678-5", 0},
+		{"Trailing Letters", "RUT 12.345.678-5abc", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "RUT 12.345.678-5 🚀", 1},
+		{"Sentence End", "Mi RUT es 12.345.678-5.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzRUT -fuzztime=10s
+func FuzzRUTDetector(f *testing.F) {
+	d := NewRUTDetector()
+
+	f.Add("12.345.678-5")
+	f.Add("10000013-K")
+	f.Add("1.2.3.4.5.6.7.8-")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,73 @@
+package detectors
+
+// SolanaDetector finds Solana addresses. They are bare Base58 encodings of a
+// 32-byte public key with no checksum, so any 32-44 character Base58 token
+// could qualify; a label such as "solana", "wallet" or "pubkey" shortly
+// before the value is required.
+type SolanaDetector struct{}
+
+func (d *SolanaDetector) Name() string {
+	return "crypto_solana"
+}
+
+// solanaWindow is how far before the address the label may appear.
+const solanaWindow = 32
+
+var solanaKeywords = []string{
+	"solana", "sol", "wallet", "address", "pubkey", "public key", "phantom",
+}
+
+func (d *SolanaDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *SolanaDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *SolanaDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var key [32]byte
+
+	for i := 0; i+32 <= len(input); i++ {
+		if !isBase58Char(input[i]) {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		end := base58Run(input, i)
+		if end < 0 {
+			continue
+		}
+		if n := end - i; n < 32 || n > 44 {
+			i = end
+			continue
+		}
+		if size, ok := decodeBase58(input[i:end], key[:]); !ok || size != 32 {
+			i = end
+			continue
+		}
+		if keywordBefore(input, i, solanaWindow, solanaKeywords) == "" {
+			i = end
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeSolanaAddress,
+			Score:      0.8,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewSolanaDetector() Detector {
+	return &SolanaDetector{}
+}
@@ -0,0 +1,54 @@
+package detectors
+
+import "testing"
+
+func TestSolanaDetector(t *testing.T) {
+	d := NewSolanaDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Labelled Wallet", "Solana wallet: 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", 1},
+		{"Pubkey Label", "pubkey=9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", 1},
+		{"Public Key Label", "Public key 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM.", 1},
+
+		// Context
+		{"No Label", "ref 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", 0},
+		{"Word Starting With Sol", "solution 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", 0},
+		{"Word Starting With Address", "addressing 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", 0},
+		{"Label Too Far", "wallet ........................................ 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", 0},
+
+		// Invalid Cases
+		{"Non Base58 Char", "wallet 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL0zYtAWWM", 0},
+		{"Too Short", "wallet 9WzDXwBbmkg8ZTbNMqUxvQRAyrZz", 0},
+
+		// Noise & Boundary
+		{"Glued Suffix", "wallet 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM_x", 1},
+		{"Unicode Noise", "SOL wallet 🚀 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzSolana -fuzztime=10s
+func FuzzSolanaDetector(f *testing.F) {
+	d := NewSolanaDetector()
+
+	f.Add("wallet 9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	f.Add("sol 1111111111111111111111111111111111")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
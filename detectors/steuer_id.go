@@ -0,0 +1,95 @@
+package detectors
+
+type SteuerIDDetector struct{}
+
+func (d *SteuerIDDetector) Name() string {
+	return "de_steuer_id"
+}
+
+func (d *SteuerIDDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *SteuerIDDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *SteuerIDDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [11]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		end, ok := readFixedDigits(input, i, digits[:], validSteuerIDSeparator)
+		if ok && isValidSteuerIDBytes(digits[:]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeSteuerID,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewSteuerIDDetector detects German tax identification numbers
+// (Steuerliche Identifikationsnummer), e.g. 86 095 742 719.
+func NewSteuerIDDetector() Detector {
+	return &SteuerIDDetector{}
+}
+
+func validSteuerIDSeparator(c byte, count int) bool {
+	return c == ' ' && (count == 2 || count == 5 || count == 8)
+}
+
+// isValidSteuerIDBytes checks the digit distribution rule (within the first
+// ten digits exactly one digit repeats, two or three times) and the
+// ISO 7064 MOD 11,10 check digit.
+func isValidSteuerIDBytes(id []byte) bool {
+	if id[0] == '0' {
+		return false
+	}
+
+	var seen [10]int
+	for k := 0; k < 10; k++ {
+		seen[id[k]-'0']++
+	}
+	repeated := 0
+	for _, c := range seen {
+		switch {
+		case c == 2 || c == 3:
+			repeated++
+		case c > 3:
+			return false
+		}
+	}
+	if repeated != 1 {
+		return false
+	}
+
+	product := 10
+	for k := 0; k < 10; k++ {
+		sum := (int(id[k]-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (sum * 2) % 11
+	}
+	digit := 11 - product
+	if digit == 10 {
+		digit = 0
+	}
+
+	return int(id[10]-'0') == digit
+}
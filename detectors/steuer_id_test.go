@@ -0,0 +1,50 @@
+package detectors
+
+import "testing"
+
+func TestSteuerIDDetector(t *testing.T) {
+	d := NewSteuerIDDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Plain", "Steuer-ID 86095742719 liegt vor.", 1},
+		{"Valid Spaced", "IdNr: 86 095 742 719", 1},
+		{"Valid Second", "Steuer-ID 47151689320", 1},
+		{"Valid Triple Digit", "Steuer-ID 65929970489", 1},
+
+		// Near Misses
+		{"Wrong Check Digit", "Steuer-ID 86095742718", 0},
+		{"Leading Zero", "Steuer-ID 06095742719", 0},
+		{"No Repeated Digit", "Steuer-ID 12345678903", 0},
+		{"Wrong Grouping", "IdNr: 860 95 742 719", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "Steuer-ID 86095742719 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzSteuerID -fuzztime=10s
+func FuzzSteuerIDDetector(f *testing.F) {
+	d := NewSteuerIDDetector()
+
+	f.Add("86 095 742 719")
+	f.Add("86095742719")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
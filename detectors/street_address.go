@@ -0,0 +1,240 @@
+package detectors
+
+// StreetAddressDetector is a heuristic for street addresses with a house
+// number, in the two common shapes:
+//
+//   - Portuguese, type first: "Rua Augusta, 1500", "Av. Paulista nº 1000",
+//     "Rua 7 de Setembro, 45"
+//   - English, number first: "1600 Pennsylvania Avenue", "221B Baker St"
+//
+// The street name must be capitalized (connectors such as "de" or "da"
+// aside), which keeps prose like "the rua we walked" out.
+type StreetAddressDetector struct{}
+
+func (d *StreetAddressDetector) Name() string {
+	return "street_address"
+}
+
+// streetPrefixes introduce a Portuguese street name. Lowercase; a trailing
+// '.' is part of the abbreviation.
+var streetPrefixes = []string{
+	"rua", "r.", "avenida", "av.", "av", "alameda", "al.", "travessa", "tv.",
+	"praça", "praca", "pça.", "rodovia", "rod.", "estrada", "largo", "viela",
+}
+
+// streetSuffixes close an English street name.
+var streetSuffixes = []string{
+	"street", "st", "avenue", "ave", "road", "rd", "boulevard", "blvd",
+	"lane", "ln", "drive", "dr", "court", "ct", "way", "place", "pl",
+	"terrace", "parkway", "pkwy", "highway", "hwy",
+}
+
+var streetConnectors = []string{"de", "da", "do", "dos", "das", "e"}
+
+// streetMaxWords bounds the words of a street name.
+const streetMaxWords = 6
+
+func (d *StreetAddressDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *StreetAddressDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *StreetAddressDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i < len(input); i++ {
+		if i > 0 && (isAlnumChar(input[i-1]) || input[i-1] >= 0x80) {
+			continue
+		}
+
+		var end int
+		var ok bool
+		switch c := input[i]; {
+		case isDigitChar(c):
+			end, ok = matchNumberFirstAddress(input, i)
+		case isLetter(c):
+			end, ok = matchTypeFirstAddress(input, i)
+		}
+		if !ok {
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeStreetAddress,
+			Score:      0.7,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewStreetAddressDetector() Detector {
+	return &StreetAddressDetector{}
+}
+
+// streetWordEnd returns the end of the word at i (ASCII letters, UTF-8
+// bytes, apostrophes and hyphens).
+func streetWordEnd(input string, i int) int {
+	j := i
+	for j < len(input) && (isLetter(input[j]) || input[j] >= 0x80 || input[j] == '\'' || input[j] == '-') {
+		j++
+	}
+	return j
+}
+
+// isCapitalized reports whether the word at i starts with an uppercase
+// letter, including Latin-1 capitals such as 'Á' (0xC3 0x80-0x9E).
+func isCapitalized(input string, i int) bool {
+	c := input[i]
+	if isUpperLetter(c) {
+		return true
+	}
+	return c == 0xC3 && i+1 < len(input) && input[i+1] >= 0x80 && input[i+1] <= 0x9E
+}
+
+func isWordFold(word string, list []string) bool {
+	for _, w := range list {
+		if len(w) == len(word) && hasPrefixFold(word, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// readHouseNumber reads 1-5 digits plus an optional letter (221B).
+func readHouseNumber(input string, i int) (int, bool) {
+	j := i
+	for j < len(input) && j-i < 5 && isDigitChar(input[j]) {
+		j++
+	}
+	if j == i {
+		return 0, false
+	}
+	if j < len(input) && isUpperLetter(input[j]) && (j+1 >= len(input) || !isAlnumChar(input[j+1])) {
+		j++
+	}
+	if j < len(input) && isAlnumChar(input[j]) {
+		return 0, false
+	}
+	return j, true
+}
+
+// matchTypeFirstAddress matches <prefix> <Name...>[,] [nº] <number>.
+func matchTypeFirstAddress(input string, i int) (int, bool) {
+	j := i
+	for _, p := range streetPrefixes {
+		if !hasPrefixFold(input[i:], p) {
+			continue
+		}
+		k := i + len(p)
+		if k < len(input) && input[k] == ' ' {
+			j = k
+			break
+		}
+	}
+	if j == i {
+		return 0, false
+	}
+
+	words, named := 0, false
+	for {
+		j = skipDateSpaces(input, j)
+		if j >= len(input) {
+			return 0, false
+		}
+
+		// The first word may be a number (Rua 7 de Setembro); later a number
+		// is the house number.
+		if isDigitChar(input[j]) && words > 0 {
+			return readHouseNumber(input, j)
+		}
+
+		k := j
+		if isDigitChar(input[j]) {
+			for k < len(input) && isDigitChar(input[k]) {
+				k++
+			}
+		} else {
+			k = streetWordEnd(input, j)
+			switch {
+			case k == j:
+				return 0, false
+			case isCapitalized(input, j):
+				named = true
+			case !isWordFold(input[j:k], streetConnectors):
+				return 0, false
+			}
+		}
+		words++
+		if words > streetMaxWords {
+			return 0, false
+		}
+		j = k
+
+		// Name ends at a comma or a number marker
+		if j < len(input) && input[j] == ',' {
+			j++
+			if !named {
+				return 0, false
+			}
+			return matchStreetNumber(input, skipDateSpaces(input, j))
+		}
+		if k := skipDateSpaces(input, j); named && k > j && k < len(input) && isLetter(input[k]) {
+			if end, ok := matchStreetNumber(input, k); ok {
+				return end, true
+			}
+		}
+		if j >= len(input) || input[j] != ' ' {
+			return 0, false
+		}
+	}
+}
+
+// matchStreetNumber matches an optional "nº"/"n."/"no"/"número" marker and
+// a house number at i.
+func matchStreetNumber(input string, i int) (int, bool) {
+	for _, marker := range []string{"número", "numero", "nº", "n°", "n.", "no"} {
+		if hasPrefixFold(input[i:], marker) {
+			k := skipDateSpaces(input, i+len(marker))
+			if k < len(input) && isDigitChar(input[k]) {
+				return readHouseNumber(input, k)
+			}
+		}
+	}
+	if i < len(input) && isDigitChar(input[i]) {
+		return readHouseNumber(input, i)
+	}
+	return 0, false
+}
+
+// matchNumberFirstAddress matches <number> <Name...> <Suffix>.
+func matchNumberFirstAddress(input string, i int) (int, bool) {
+	j, ok := readHouseNumber(input, i)
+	if !ok || j >= len(input) || input[j] != ' ' {
+		return 0, false
+	}
+
+	for words := 0; words < streetMaxWords; words++ {
+		j = skipDateSpaces(input, j)
+		if j >= len(input) || !isCapitalized(input, j) {
+			return 0, false
+		}
+		k := streetWordEnd(input, j)
+		if words > 0 && isWordFold(input[j:k], streetSuffixes) {
+			return k, true
+		}
+		j = k
+		if j >= len(input) || input[j] != ' ' {
+			return 0, false
+		}
+	}
+	return 0, false
+}
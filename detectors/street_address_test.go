@@ -0,0 +1,59 @@
+package detectors
+
+import "testing"
+
+func TestStreetAddressDetector(t *testing.T) {
+	d := NewStreetAddressDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		// Portuguese
+		{"Rua Comma", "Moro na Rua Augusta, 1500 apto 3", []string{"Rua Augusta, 1500"}},
+		{"Av Abbrev", "Escritório: Av. Paulista nº 1000.", []string{"Av. Paulista nº 1000"}},
+		{"Connectors", "Rua Barão de Itapetininga 255", []string{"Rua Barão de Itapetininga 255"}},
+		{"Numbered Name", "Rua 7 de Setembro, 45", []string{"Rua 7 de Setembro, 45"}},
+		{"Accented Name", "Avenida Álvares Cabral, 1200", []string{"Avenida Álvares Cabral, 1200"}},
+
+		// English
+		{"Avenue", "Visit 1600 Pennsylvania Avenue today", []string{"1600 Pennsylvania Avenue"}},
+		{"Letter Number", "221B Baker St, London", []string{"221B Baker St"}},
+		{"Two Word Name", "at 350 Fifth Avenue", []string{"350 Fifth Avenue"}},
+
+		// Not addresses
+		{"No Number", "A Rua Augusta é linda", nil},
+		{"Lowercase Name", "rua sem nome, 12", nil},
+		{"Number Without Street Type", "We sold 300 Blue Widgets", nil},
+		{"Suffix Only", "Route 66 Road", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if len(matches) != len(tt.expected) {
+				t.Fatalf("input: %q\nexpected %d matches, got %d: %+v", tt.input, len(tt.expected), len(matches), matches)
+			}
+			for k, m := range matches {
+				if m.Value != tt.expected[k] {
+					t.Errorf("match %d: expected %q, got %q", k, tt.expected[k], m.Value)
+				}
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzStreetAddress -fuzztime=10s
+func FuzzStreetAddressDetector(f *testing.F) {
+	d := NewStreetAddressDetector()
+
+	f.Add("Rua Augusta, 1500")
+	f.Add("221B Baker St")
+	f.Add("Av. ")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,80 @@
+package detectors
+
+type TFNDetector struct{}
+
+func (d *TFNDetector) Name() string {
+	return "au_tfn"
+}
+
+func (d *TFNDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *TFNDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *TFNDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var digits [9]byte
+
+	for i := 0; i < len(input); i++ {
+		if !isDigitChar(input[i]) {
+			continue
+		}
+		if i > 0 && isDigitChar(input[i-1]) {
+			continue
+		}
+
+		// Current TFNs have 9 digits; some older ones still in use have 8.
+		end, ok := readFixedDigits(input, i, digits[:], validTFNSeparator)
+		if ok && isValidTFNBytes(digits[:]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeTFN,
+				Score:      1.0,
+			})
+			i = end - 1
+			continue
+		}
+		end, ok = readFixedDigits(input, i, digits[:8], validTFNSeparator)
+		if ok && isValidTFNBytes(digits[:8]) {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeTFN,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewTFNDetector detects Australian Tax File Numbers (123 456 782).
+func NewTFNDetector() Detector {
+	return &TFNDetector{}
+}
+
+func validTFNSeparator(c byte, count int) bool {
+	return (c == ' ' || c == '-') && (count == 3 || count == 6)
+}
+
+// isValidTFNBytes checks the weighted Mod11 rule for 8 or 9 digit TFNs.
+func isValidTFNBytes(tfn []byte) bool {
+	weights := [9]int{1, 4, 3, 7, 5, 8, 6, 9, 10}
+	if len(tfn) == 8 {
+		weights = [9]int{10, 7, 8, 4, 6, 3, 5, 1}
+	}
+
+	sum := 0
+	for i := 0; i < len(tfn); i++ {
+		sum += int(tfn[i]-'0') * weights[i]
+	}
+	return sum != 0 && sum%11 == 0
+}
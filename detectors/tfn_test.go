@@ -0,0 +1,48 @@
+package detectors
+
+import "testing"
+
+func TestTFNDetector(t *testing.T) {
+	d := NewTFNDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Valid Spaced", "TFN 123 456 782 lodged.", 1},
+		{"Valid Plain", "TFN: 876543210", 1},
+		{"Valid Eight Digit", "Old TFN 37118629", 1},
+
+		// Near Misses
+		{"Invalid Checksum", "TFN 123 456 789", 0},
+		{"All Zeros", "TFN 000 000 000", 0},
+		{"Ten Digits", "TFN 1234567820", 0},
+
+		// Noise & Boundary
+		{"Unicode Noise", "TFN 123 456 782 🚀", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzTFN -fuzztime=10s
+func FuzzTFNDetector(f *testing.F) {
+	d := NewTFNDetector()
+
+	f.Add("123 456 782")
+	f.Add("37118629")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,52 @@
+package detectors
+
+type TronDetector struct{}
+
+func (d *TronDetector) Name() string {
+	return "crypto_tron"
+}
+
+func (d *TronDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *TronDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *TronDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+34 <= len(input); i++ {
+		if input[i] != 'T' {
+			continue
+		}
+		if i > 0 && isAlnumChar(input[i-1]) {
+			continue
+		}
+
+		end := base58Run(input, i)
+		if end-i != 34 {
+			continue
+		}
+		if version, ok := isValidBase58Check(input[i:end]); ok && version == 0x41 {
+			results = append(results, Match{
+				StartIndex: i,
+				EndIndex:   end,
+				Value:      input[i:end],
+				Type:       TypeTronAddress,
+				Score:      1.0,
+			})
+			i = end - 1
+		}
+	}
+
+	return results
+}
+
+// NewTronDetector detects Tron addresses (T..., 34 chars) with a valid
+// Base58Check checksum and the 0x41 version byte.
+func NewTronDetector() Detector {
+	return &TronDetector{}
+}
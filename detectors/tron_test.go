@@ -0,0 +1,48 @@
+package detectors
+
+import "testing"
+
+func TestTronDetector(t *testing.T) {
+	d := NewTronDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"USDT Contract", "TRC20 TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", 1},
+		{"Sentence End", "Deposit to TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t.", 1},
+
+		// Invalid Cases
+		{"Bad Checksum", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", 0},
+		{"Bitcoin Address", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 0},
+		{"Too Short", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6", 0},
+
+		// Noise & Boundary
+		{"Glued Prefix", "xTR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", 0},
+		{"Unicode Noise", "TRX 🚀 TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzTron -fuzztime=10s
+func FuzzTronDetector(f *testing.F) {
+	d := NewTronDetector()
+
+	f.Add("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	f.Add("T")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
@@ -0,0 +1,126 @@
+package detectors
+
+// UKPostcodeDetector finds UK postcodes such as "SW1A 1AA", "M1 1AE" or
+// "EC1A 1BB". Uppercase codes with the usual single space are accepted on
+// their own; lowercase or unspaced codes need a "postcode" keyword in front.
+type UKPostcodeDetector struct{}
+
+func (d *UKPostcodeDetector) Name() string {
+	return "uk_postcode"
+}
+
+var ukPostcodeKeywords = []string{"postcode", "post code"}
+
+func (d *UKPostcodeDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *UKPostcodeDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *UKPostcodeDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+5 <= len(input); i++ {
+		if !isLetter(input[i]) || (i > 0 && isAlnumChar(input[i-1])) {
+			continue
+		}
+
+		end, strict, ok := matchUKPostcode(input, i)
+		if !ok {
+			continue
+		}
+		score := float32(0.9)
+		if keywordBefore(input, i, postalKeywordWindow, ukPostcodeKeywords) != "" {
+			score = 1.0
+		} else if !strict {
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeUKPostcode,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewUKPostcodeDetector() Detector {
+	return &UKPostcodeDetector{}
+}
+
+func upperASCII(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - ('a' - 'A')
+	}
+	return c
+}
+
+// matchUKPostcode matches outward code (A9, A99, A9A, AA9, AA99, AA9A),
+// an optional space and inward code (9AA). strict is false when the code is
+// not uppercase or not spaced.
+func matchUKPostcode(input string, i int) (end int, strict, ok bool) {
+	strict = true
+	upper := func(k int) byte {
+		if input[k] >= 'a' && input[k] <= 'z' {
+			strict = false
+		}
+		return upperASCII(input[k])
+	}
+
+	// Outward code
+	j := i
+	if c := upper(j); c == 'Q' || c == 'V' || c == 'X' {
+		return 0, false, false
+	}
+	j++
+	if isLetter(input[j]) {
+		if c := upper(j); c == 'I' || c == 'J' || c == 'Z' {
+			return 0, false, false
+		}
+		j++
+	}
+	if j >= len(input) || !isDigitChar(input[j]) {
+		return 0, false, false
+	}
+	j++
+	if j < len(input) && (isDigitChar(input[j]) || (isLetter(input[j]) && j+1 < len(input) && !isLetter(input[j+1]))) {
+		if isLetter(input[j]) {
+			upper(j)
+		}
+		j++
+	}
+
+	// Separator
+	if j < len(input) && input[j] == ' ' {
+		j++
+	} else {
+		strict = false
+	}
+
+	// Inward code: 9AA, the letters never being C, I, K, M, O or V
+	if j+3 > len(input) || !isDigitChar(input[j]) {
+		return 0, false, false
+	}
+	for k := j + 1; k < j+3; k++ {
+		if !isLetter(input[k]) {
+			return 0, false, false
+		}
+		switch upper(k) {
+		case 'C', 'I', 'K', 'M', 'O', 'V':
+			return 0, false, false
+		}
+	}
+	end = j + 3
+	if end < len(input) && isAlnumChar(input[end]) {
+		return 0, false, false
+	}
+	return end, strict, true
+}
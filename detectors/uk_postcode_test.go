@@ -0,0 +1,56 @@
+package detectors
+
+import "testing"
+
+func TestUKPostcodeDetector(t *testing.T) {
+	d := NewUKPostcodeDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"A9A 9AA", "10 Downing St, London SW1A 2AA", 1},
+		{"A9 9AA", "Manchester M1 1AE", 1},
+		{"A99 9AA", "Birmingham B33 8TH", 1},
+		{"AA9 9AA", "Croydon CR2 6XH", 1},
+		{"AA99 9AA", "Leeds LS10 1AB", 1},
+		{"Lowercase With Keyword", "postcode: sw1a 2aa", 1},
+		{"Unspaced With Keyword", "Postcode SW1A2AA", 1},
+
+		// Context
+		{"Lowercase Without Keyword", "see sw1a 2aa", 0},
+		{"Unspaced Without Keyword", "ref SW1A2AA", 0},
+
+		// Invalid Cases
+		{"Bad First Letter", "QA1 1AA", 0},
+		{"Bad Inward Letter", "SW1A 2CA", 0},
+		{"Glued Prefix", "XSW1A 2AA", 0},
+
+		// Noise & Boundary
+		{"Sentence End", "Ship to EC1A 1BB.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzUKPostcode -fuzztime=10s
+func FuzzUKPostcodeDetector(f *testing.F) {
+	d := NewUKPostcodeDetector()
+
+	f.Add("SW1A 2AA")
+	f.Add("M1 1A")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
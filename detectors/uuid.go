@@ -1,5 +1,7 @@
 package detectors
 
+// UUIDDetector finds UUIDs/GUIDs. A UUID labelled as an advertising or vendor
+// ID (see DeviceIDDetector) is reported as TypeDeviceID instead of TypeUUID.
 type UUIDDetector struct{}
 
 func (d *UUIDDetector) Name() string {
@@ -7,19 +9,33 @@ func (d *UUIDDetector) Name() string {
 }
 
 func (d *UUIDDetector) Scan(input string) []Match {
-	var results []Match
+	return ownMetadata(d.scan(nil, input))
+}
+
+// ScanAppend implements AppendScanner.
+func (d *UUIDDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *UUIDDetector) scan(dst []Match, input string) []Match {
+	results := dst
 	for i := 0; i < len(input); i++ {
 		if !isHexChar(input[i]) {
 			continue
 		}
 		if end, ok := matchUUID(input, i); ok {
-			results = append(results, Match{
+			m := Match{
 				StartIndex: i,
 				EndIndex:   end,
 				Value:      input[i:end],
 				Type:       TypeUUID,
 				Score:      1.0,
-			})
+			}
+			if kind := advertisingIDKind(input, i); kind != "" {
+				m.Type = TypeDeviceID
+				m.Metadata = deviceIDMetadata[kind]
+			}
+			results = append(results, m)
 			i = end - 1
 		}
 	}
@@ -0,0 +1,135 @@
+package detectors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Validator checks the value of a candidate match, e.g. its check digit.
+type Validator func(value string) bool
+
+// validators are the named validators available to pattern detectors and
+// configuration files; see RegisterValidator.
+var (
+	validatorsMu sync.RWMutex
+	validators   = map[string]Validator{
+		"luhn":     ValidateLuhn,
+		"mod11":    ValidateMod11,
+		"mod97":    ValidateMod97,
+		"verhoeff": ValidateVerhoeff,
+	}
+)
+
+// validatorMaxDigits bounds the digits a validator reads without allocating.
+const validatorMaxDigits = 64
+
+// validatorDigits collects the digits of value, skipping common separators
+// (space, '-', '.', '/'). Any other character fails.
+func validatorDigits(value string, buf []byte) ([]byte, bool) {
+	n := 0
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case isDigitChar(c):
+			if n == len(buf) {
+				return nil, false
+			}
+			buf[n] = c
+			n++
+		case c == ' ' || c == '-' || c == '.' || c == '/':
+		default:
+			return nil, false
+		}
+	}
+	return buf[:n], n > 1
+}
+
+// ValidateLuhn checks the Luhn (mod 10) check digit used by cards and IMEIs.
+func ValidateLuhn(value string) bool {
+	var buf [validatorMaxDigits]byte
+	digits, ok := validatorDigits(value, buf[:])
+	return ok && isValidLuhnBytes(digits)
+}
+
+// ValidateMod11 checks a trailing mod 11 check digit: the other digits are
+// weighted 2, 3, ..., 9 (repeating) from the right, and the check digit is
+// 11 - sum%11, with 10 and 11 written as 0.
+func ValidateMod11(value string) bool {
+	var buf [validatorMaxDigits]byte
+	digits, ok := validatorDigits(value, buf[:])
+	if !ok {
+		return false
+	}
+	sum, weight := 0, 2
+	for i := len(digits) - 2; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+	check := 11 - sum%11
+	if check >= 10 {
+		check = 0
+	}
+	return check == int(digits[len(digits)-1]-'0')
+}
+
+// ValidateMod97 checks ISO 7064 MOD 97-10: the whole value, letters read as
+// 10-35, must be 1 modulo 97 (LEIs, rearranged IBANs). Spaces and '-' are
+// ignored.
+func ValidateMod97(value string) bool {
+	rem, n := 0, 0
+	for i := 0; i < len(value); i++ {
+		c := upperASCII(value[i])
+		switch {
+		case isDigitChar(c):
+			rem = (rem*10 + int(c-'0')) % 97
+		case isUpperLetter(c):
+			rem = (rem*100 + int(c-'A') + 10) % 97
+		case c == ' ' || c == '-':
+			continue
+		default:
+			return false
+		}
+		n++
+	}
+	return n > 2 && rem == 1
+}
+
+// ValidateVerhoeff checks the Verhoeff check digit used by Aadhaar.
+func ValidateVerhoeff(value string) bool {
+	var buf [validatorMaxDigits]byte
+	digits, ok := validatorDigits(value, buf[:])
+	return ok && isValidVerhoeffBytes(digits)
+}
+
+// RegisterValidator makes fn available to PatternValidator under name,
+// which is case-insensitive. Names are unique. Custom validators are
+// usually registered from init.
+func RegisterValidator(name string, fn Validator) error {
+	if name == "" {
+		return fmt.Errorf("validator registration needs a name")
+	}
+	if fn == nil {
+		return fmt.Errorf("validator %q: nil func", name)
+	}
+	name = strings.ToLower(name)
+
+	validatorsMu.Lock()
+	defer validatorsMu.Unlock()
+	if _, ok := validators[name]; ok {
+		return fmt.Errorf("validator %q is already registered", name)
+	}
+	validators[name] = fn
+	return nil
+}
+
+// LookupValidator returns the validator registered under name,
+// case-insensitively.
+func LookupValidator(name string) (Validator, bool) {
+	validatorsMu.RLock()
+	defer validatorsMu.RUnlock()
+	v, ok := validators[strings.ToLower(name)]
+	return v, ok
+}
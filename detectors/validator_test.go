@@ -0,0 +1,74 @@
+package detectors
+
+import "testing"
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator string
+		value     string
+		expected  bool
+	}{
+		{"Luhn Card", "luhn", "4111 1111 1111 1111", true},
+		{"Luhn Bad", "luhn", "4111 1111 1111 1112", false},
+		{"Mod11", "mod11", "123455", true},
+		{"Mod11 Check Zero", "mod11", "1234560", true},
+		{"Mod11 Bad", "mod11", "123456", false},
+		{"Mod11 Separators", "mod11", "12345-5", true},
+		{"Mod97 Rearranged IBAN", "mod97", "WEST12345698765432GB82", true},
+		{"Mod97 LEI", "mod97", "5493001KJTIIGC8Y1R12", true},
+		{"Mod97 Bad", "mod97", "5493001KJTIIGC8Y1R13", false},
+		{"Verhoeff", "verhoeff", "2363", true},
+		{"Verhoeff Bad", "verhoeff", "2364", false},
+		{"Letters Rejected", "luhn", "4111A", false},
+		{"Uppercase Name", "LUHN", "79927398713", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := LookupValidator(tt.validator)
+			if !ok {
+				t.Fatalf("validator %q not registered", tt.validator)
+			}
+			if got := v(tt.value); got != tt.expected {
+				t.Errorf("%s(%q) = %v, want %v", tt.validator, tt.value, got, tt.expected)
+			}
+		})
+	}
+
+	if _, ok := LookupValidator("crc32"); ok {
+		t.Error("unknown validator must not be found")
+	}
+}
+
+func TestRegisterValidator(t *testing.T) {
+	even := func(value string) bool { return len(value)%2 == 0 }
+	if err := RegisterValidator("Test_Even", even); err != nil {
+		t.Fatal(err)
+	}
+	v, ok := LookupValidator("test_even")
+	if !ok || !v("ab") || v("abc") {
+		t.Fatal("registered validator not found under its lower-case name")
+	}
+
+	if err := RegisterValidator("TEST_EVEN", even); err == nil {
+		t.Error("expected an error for a duplicate name")
+	}
+	if err := RegisterValidator("luhn", even); err == nil {
+		t.Error("expected an error when replacing a built-in validator")
+	}
+	if err := RegisterValidator("", even); err == nil {
+		t.Error("expected an error for an empty name")
+	}
+	if err := RegisterValidator("test_nil", nil); err == nil {
+		t.Error("expected an error for a nil validator")
+	}
+
+	d, err := NewPattern("even_ref", TypeCustom, `REF-\d+`, PatternValidator("test_even"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Scan("REF-12 REF-123"); len(got) != 1 || got[0].Value != "REF-12" {
+		t.Errorf("Scan = %v, want only REF-12", got)
+	}
+}
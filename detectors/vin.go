@@ -0,0 +1,116 @@
+package detectors
+
+// VINDetector finds 17-character Vehicle Identification Numbers whose
+// ISO 3779 check digit (position 9, North American rule) is valid.
+// Lowercase VINs need a "VIN" or "chassi" keyword in front.
+type VINDetector struct{}
+
+func (d *VINDetector) Name() string {
+	return "global_vin"
+}
+
+var vinKeywords = []string{"vin", "chassi", "chassis"}
+
+// vinWeights are the ISO 3779 position weights.
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+func (d *VINDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *VINDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *VINDetector) scan(dst []Match, input string) []Match {
+	results := dst
+	var vin [17]byte
+
+	for i := 0; i+17 <= len(input); i++ {
+		if !isAlnumChar(input[i]) || (i > 0 && isAlnumChar(input[i-1])) {
+			continue
+		}
+		end := i + 17
+		if end < len(input) && isAlnumChar(input[end]) {
+			continue
+		}
+
+		upper := true
+		for k := 0; k < 17; k++ {
+			c := input[i+k]
+			if c >= 'a' && c <= 'z' {
+				upper = false
+			}
+			vin[k] = upperASCII(c)
+		}
+		if !isValidVINBytes(vin[:]) {
+			continue
+		}
+		score := float32(0.9)
+		if keywordBefore(input, i, plateKeywordWindow, vinKeywords) != "" {
+			score = 1.0
+		} else if !upper {
+			continue
+		}
+
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeVIN,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewVINDetector() Detector {
+	return &VINDetector{}
+}
+
+// vinValue transliterates a VIN character; I, O and Q are not allowed.
+func vinValue(c byte) int {
+	switch {
+	case isDigitChar(c):
+		return int(c - '0')
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1
+	case c == 'P':
+		return 7
+	case c == 'R':
+		return 9
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2
+	}
+	return -1
+}
+
+// isValidVINBytes checks the alphabet and the check digit of an uppercase
+// VIN. All-digit strings are rejected: real VINs carry letters.
+func isValidVINBytes(vin []byte) bool {
+	sum, letters := 0, 0
+	for k, c := range vin {
+		v := vinValue(c)
+		if v < 0 {
+			return false
+		}
+		if !isDigitChar(c) {
+			letters++
+		}
+		sum += v * vinWeights[k]
+	}
+	if letters == 0 {
+		return false
+	}
+
+	check := byte('0' + sum%11)
+	if sum%11 == 10 {
+		check = 'X'
+	}
+	return vin[8] == check
+}
@@ -0,0 +1,50 @@
+package detectors
+
+import "testing"
+
+func TestVINDetector(t *testing.T) {
+	d := NewVINDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"Honda", "VIN 1HGCM82633A004352 reported stolen", 1},
+		{"Check Digit X", "Vehicle 1M8GDM9AXKP042788", 1},
+		{"Lowercase With Keyword", "chassi: 1hgcm82633a004352", 1},
+
+		// Invalid Cases
+		{"Bad Check Digit", "VIN 1HGCM82643A004352", 0},
+		{"Forbidden Letter O", "VIN 1HGCM82633O004352", 0},
+		{"All Digits", "Ref 11111111111111111", 0},
+		{"Lowercase Without Keyword", "hash 1hgcm82633a004352", 0},
+		{"Too Long", "1HGCM82633A0043521", 0},
+
+		// Noise & Boundary
+		{"Sentence End", "Chassi 1HGCM82633A004352.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzVIN -fuzztime=10s
+func FuzzVINDetector(f *testing.F) {
+	d := NewVINDetector()
+
+	f.Add("1HGCM82633A004352")
+	f.Add("vin 1M8GDM9AXKP042788")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
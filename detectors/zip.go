@@ -0,0 +1,104 @@
+package detectors
+
+// ZIPDetector finds US ZIP and ZIP+4 codes. Five-digit numbers are everywhere,
+// so a code needs context: a "ZIP" keyword or a state abbreviation right
+// before it ("Springfield, IL 62704").
+type ZIPDetector struct{}
+
+func (d *ZIPDetector) Name() string {
+	return "us_zip"
+}
+
+var zipKeywords = []string{"zip"}
+
+// usStates holds the USPS codes of the states, DC and territories.
+const usStates = "AL AK AZ AR CA CO CT DE FL GA HI ID IL IN IA KS KY LA ME MD " +
+	"MA MI MN MS MO MT NE NV NH NJ NM NY NC ND OH OK OR PA RI SC " +
+	"SD TN TX UT VT VA WA WV WI WY DC PR GU VI AS MP"
+
+func (d *ZIPDetector) Scan(input string) []Match {
+	return d.scan(nil, input)
+}
+
+// ScanAppend implements AppendScanner.
+func (d *ZIPDetector) ScanAppend(dst []Match, input []byte) []Match {
+	return d.scan(dst, bytesToString(input))
+}
+
+func (d *ZIPDetector) scan(dst []Match, input string) []Match {
+	results := dst
+
+	for i := 0; i+5 <= len(input); i++ {
+		if !isDigitChar(input[i]) || (i > 0 && (isAlnumChar(input[i-1]) || input[i-1] == '-')) {
+			continue
+		}
+
+		end := i
+		for end < len(input) && end-i < 5 && isDigitChar(input[end]) {
+			end++
+		}
+		if end-i != 5 {
+			continue
+		}
+		if end+5 <= len(input) && input[end] == '-' && isAllDigits(input[end+1:end+5]) {
+			end += 5
+		}
+		if end < len(input) && (isAlnumChar(input[end]) ||
+			(input[end] == '-' && end+1 < len(input) && isDigitChar(input[end+1]))) {
+			continue
+		}
+
+		score := float32(0)
+		switch {
+		case usStateBefore(input, i):
+			score = 1.0
+		case keywordBefore(input, i, postalKeywordWindow, zipKeywords) != "":
+			score = 0.9
+		default:
+			continue
+		}
+		results = append(results, Match{
+			StartIndex: i,
+			EndIndex:   end,
+			Value:      input[i:end],
+			Type:       TypeZIPCode,
+			Score:      score,
+		})
+		i = end - 1
+	}
+
+	return results
+}
+
+func NewZIPDetector() Detector {
+	return &ZIPDetector{}
+}
+
+func isAllDigits(s string) bool {
+	for k := 0; k < len(s); k++ {
+		if !isDigitChar(s[k]) {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+// usStateBefore reports whether a standalone state code and a space precede i.
+func usStateBefore(input string, i int) bool {
+	j := i
+	for j > 0 && input[j-1] == ' ' {
+		j--
+	}
+	if j == i || j < 2 || !isUpperLetter(input[j-1]) || !isUpperLetter(input[j-2]) {
+		return false
+	}
+	if j > 2 && isAlnumChar(input[j-3]) {
+		return false
+	}
+	for k := 0; k+2 <= len(usStates); k += 3 {
+		if usStates[k] == input[j-2] && usStates[k+1] == input[j-1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package detectors
+
+import "testing"
+
+func TestZIPDetector(t *testing.T) {
+	d := NewZIPDetector()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		// Valid Cases
+		{"After State", "Springfield, IL 62704", 1},
+		{"ZIP+4 After State", "New York, NY 10001-1234", 1},
+		{"Keyword", "ZIP code: 94105", 1},
+		{"ZIP+4 Keyword", "zip 94105-0011", 1},
+
+		// Context
+		{"No Context", "Order 62704 shipped", 0},
+		{"BR Mobile Shape", "Celular 91234-5678", 0},
+		{"Not A State", "Room XY 62704", 0},
+		{"Glued State", "ANY 62704", 0},
+
+		// Invalid Cases
+		{"Six Digits", "ZIP 627041", 0},
+		{"Four Digits", "ZIP 6270", 0},
+
+		// Noise & Boundary
+		{"Sentence End", "Mail it to Austin, TX 78701.", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := d.Scan(tt.input)
+			if got := len(matches); got != tt.expected {
+				t.Errorf("input: %q\nexpected %d matches, got %d", tt.input, tt.expected, got)
+			}
+		})
+	}
+}
+
+// Run with: go test -fuzz=FuzzZIP -fuzztime=10s
+func FuzzZIPDetector(f *testing.F) {
+	d := NewZIPDetector()
+
+	f.Add("NY 10001-1234")
+	f.Add("zip 9")
+
+	f.Fuzz(func(t *testing.T, orig string) {
+		// MUST NOT PANIC
+		_ = d.Scan(orig)
+	})
+}
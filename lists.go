@@ -0,0 +1,366 @@
+package veil
+
+import (
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/veil-services/veil-go/detectors"
+)
+
+// AnyType in an allow list applies to matches of every type.
+const AnyType detectors.PIIType = "*"
+
+// List values are compared after a normalization that depends on the type:
+// numeric identifiers by their digits ("4242 4242 4242 4242" allows
+// "4242424242424242"), case-insensitive identifiers folded to lower case,
+// everything else as written. IP entries may also be CIDR ranges.
+type listNorm uint8
+
+const (
+	normExact listNorm = iota
+	normDigits
+	normFold
+	listNormCount
+)
+
+var listNorms = map[detectors.PIIType]listNorm{
+	detectors.TypeCPF:        normDigits,
+	detectors.TypeCNPJ:       normDigits,
+	detectors.TypeCreditCard: normDigits,
+	detectors.TypePhone:      normDigits,
+	detectors.TypeCEP:        normDigits,
+	detectors.TypeCUIT:       normDigits,
+	detectors.TypeNHS:        normDigits,
+	detectors.TypeBSN:        normDigits,
+	detectors.TypeNIF:        normDigits,
+	detectors.TypeAadhaar:    normDigits,
+	detectors.TypeTFN:        normDigits,
+	detectors.TypeMedicare:   normDigits,
+	detectors.TypeIMEI:       normDigits,
+	detectors.TypeNPI:        normDigits,
+	detectors.TypeRENAVAM:    normDigits,
+
+	detectors.TypeEmail:    normFold,
+	detectors.TypeUUID:     normFold,
+	detectors.TypeDeviceID: normFold,
+	detectors.TypeMAC:      normFold,
+}
+
+func (n listNorm) key(value string) string {
+	switch n {
+	case normDigits:
+		var sb strings.Builder
+		for i := 0; i < len(value); i++ {
+			if value[i] >= '0' && value[i] <= '9' {
+				sb.WriteByte(value[i])
+			}
+		}
+		return sb.String()
+	case normFold:
+		return asciiLower(value)
+	}
+	return value
+}
+
+// valueLists holds the allow and deny lists of an engine.
+type valueLists struct {
+	allow         map[detectors.PIIType]map[string]struct{}
+	allowAny      [listNormCount]map[string]struct{}
+	allowNets     []*net.IPNet // applied to IP matches
+	allowPatterns map[detectors.PIIType][]*regexp.Regexp
+
+	deny         []deniedValue
+	denyNets     []*net.IPNet
+	denyPatterns []deniedPattern
+}
+
+type deniedValue struct {
+	typ   detectors.PIIType
+	value string
+	norm  listNorm
+	key   string
+}
+
+type deniedPattern struct {
+	typ detectors.PIIType
+	re  *regexp.Regexp
+}
+
+func newValueLists(c *Config) valueLists {
+	var l valueLists
+	for typ, values := range c.Allow {
+		for _, v := range values {
+			if ipNet := parseListCIDR(typ, v); ipNet != nil {
+				l.allowNets = append(l.allowNets, ipNet)
+				continue
+			}
+			if typ == AnyType {
+				for n := listNorm(0); n < listNormCount; n++ {
+					addListKey(&l.allowAny[n], n.key(v))
+				}
+				continue
+			}
+			if l.allow == nil {
+				l.allow = make(map[detectors.PIIType]map[string]struct{})
+			}
+			set := l.allow[typ]
+			addListKey(&set, listNorms[typ].key(v))
+			l.allow[typ] = set
+		}
+	}
+	l.allowPatterns = c.AllowPatterns
+
+	for typ, values := range c.Deny {
+		for _, v := range values {
+			if ipNet := parseListCIDR(typ, v); ipNet != nil {
+				l.denyNets = append(l.denyNets, ipNet)
+				continue
+			}
+			norm := listNorms[typ]
+			if key := norm.key(v); key != "" {
+				l.deny = append(l.deny, deniedValue{typ: typ, value: v, norm: norm, key: key})
+			}
+		}
+	}
+	for typ, patterns := range c.DenyPatterns {
+		for _, re := range patterns {
+			l.denyPatterns = append(l.denyPatterns, deniedPattern{typ: typ, re: re})
+		}
+	}
+
+	// Map order is random; keep the output stable
+	sort.Slice(l.deny, func(i, j int) bool {
+		if l.deny[i].typ != l.deny[j].typ {
+			return l.deny[i].typ < l.deny[j].typ
+		}
+		return l.deny[i].value < l.deny[j].value
+	})
+	sort.SliceStable(l.denyPatterns, func(i, j int) bool {
+		return l.denyPatterns[i].typ < l.denyPatterns[j].typ
+	})
+	return l
+}
+
+func addListKey(set *map[string]struct{}, key string) {
+	if key == "" {
+		return
+	}
+	if *set == nil {
+		*set = make(map[string]struct{})
+	}
+	(*set)[key] = struct{}{}
+}
+
+// parseListCIDR returns the network of an IP list entry such as
+// "10.0.0.0/8", or nil.
+func parseListCIDR(typ detectors.PIIType, v string) *net.IPNet {
+	if (typ != detectors.TypeIP && typ != AnyType) || !strings.Contains(v, "/") {
+		return nil
+	}
+	_, ipNet, err := net.ParseCIDR(strings.TrimSpace(v))
+	if err != nil {
+		return nil
+	}
+	return ipNet
+}
+
+func (l *valueLists) empty() bool {
+	for _, set := range l.allowAny {
+		if len(set) > 0 {
+			return false
+		}
+	}
+	return len(l.allow) == 0 && len(l.allowNets) == 0 && len(l.allowPatterns) == 0 &&
+		len(l.deny) == 0 && len(l.denyNets) == 0 && len(l.denyPatterns) == 0
+}
+
+// apply drops allowed matches and adds the occurrences of denied values.
+// It runs between scanning and overlap resolution.
+func (l *valueLists) apply(input string, matches []detectors.Match) []detectors.Match {
+	if l.empty() {
+		return matches
+	}
+
+	n := 0
+	for _, m := range matches {
+		if l.allowed(m) {
+			continue
+		}
+		matches[n] = m
+		n++
+	}
+	matches = matches[:n]
+
+	var folded string
+	for _, d := range l.deny {
+		switch d.norm {
+		case normDigits:
+			matches = appendDigitsDenied(matches, input, d)
+		case normFold:
+			if folded == "" {
+				folded = asciiLower(input)
+			}
+			matches = appendDenied(matches, input, folded, d)
+		default:
+			matches = appendDenied(matches, input, input, d)
+		}
+	}
+
+	if len(l.denyNets) > 0 {
+		for _, m := range detectors.NewIPDetector().Scan(input) {
+			if ip := net.ParseIP(m.Value); ip != nil && inNets(l.denyNets, ip) {
+				matches = append(matches, m)
+			}
+		}
+	}
+
+	for _, d := range l.denyPatterns {
+		for _, loc := range d.re.FindAllStringIndex(input, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			matches = append(matches, detectors.Match{
+				StartIndex: loc[0],
+				EndIndex:   loc[1],
+				Value:      input[loc[0]:loc[1]],
+				Type:       d.typ,
+				Score:      1.0,
+			})
+		}
+	}
+
+	return matches
+}
+
+func (l *valueLists) allowed(m detectors.Match) bool {
+	norm := listNorms[m.Type]
+	key := norm.key(m.Value)
+	if _, ok := l.allow[m.Type][key]; ok {
+		return true
+	}
+	if _, ok := l.allowAny[norm][key]; ok {
+		return true
+	}
+	if m.Type == detectors.TypeIP && len(l.allowNets) > 0 {
+		if ip := net.ParseIP(m.Value); ip != nil && inNets(l.allowNets, ip) {
+			return true
+		}
+	}
+	for _, re := range l.allowPatterns[m.Type] {
+		if re.MatchString(m.Value) {
+			return true
+		}
+	}
+	for _, re := range l.allowPatterns[AnyType] {
+		if re.MatchString(m.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+func inNets(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendDenied adds the occurrences of d.key in haystack, a copy of input
+// with the same offsets.
+func appendDenied(matches []detectors.Match, input, haystack string, d deniedValue) []detectors.Match {
+	for from := 0; from < len(haystack); {
+		i := strings.Index(haystack[from:], d.key)
+		if i < 0 {
+			break
+		}
+		start := from + i
+		end := start + len(d.key)
+		from = end
+		if !isListBoundary(input, start, end) {
+			continue
+		}
+		matches = append(matches, detectors.Match{
+			StartIndex: start,
+			EndIndex:   end,
+			Value:      input[start:end],
+			Type:       d.typ,
+			Score:      1.0,
+		})
+	}
+	return matches
+}
+
+// appendDigitsDenied adds the numbers of input whose digits are d.key,
+// whatever their separators ("4242 4242 4242 4242", "4242-4242-...").
+func appendDigitsDenied(matches []detectors.Match, input string, d deniedValue) []detectors.Match {
+	for i := 0; i < len(input); i++ {
+		if input[i] != d.key[0] || (i > 0 && isListWordByte(input[i-1])) {
+			continue
+		}
+		j, k := i, 0
+		for j < len(input) && k < len(d.key) {
+			c := input[j]
+			if c >= '0' && c <= '9' {
+				if c != d.key[k] {
+					break
+				}
+				k++
+				j++
+				continue
+			}
+			// one separator between digits
+			if !isListSeparator(c) || j+1 >= len(input) || input[j+1] < '0' || input[j+1] > '9' {
+				break
+			}
+			j++
+		}
+		if k != len(d.key) || (j < len(input) && isListWordByte(input[j])) {
+			continue
+		}
+		matches = append(matches, detectors.Match{
+			StartIndex: i,
+			EndIndex:   j,
+			Value:      input[i:j],
+			Type:       d.typ,
+			Score:      1.0,
+		})
+		i = j - 1
+	}
+	return matches
+}
+
+func isListSeparator(c byte) bool {
+	return c == ' ' || c == '-' || c == '.' || c == '/'
+}
+
+func isListWordByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// asciiLower lower-cases ASCII letters only, keeping byte offsets.
+func asciiLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if c >= 'A' && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
+
+// isListBoundary reports whether a denied value at [start, end) is not part
+// of a longer word or number.
+func isListBoundary(input string, start, end int) bool {
+	if start > 0 && isListWordByte(input[start-1]) && isListWordByte(input[start]) {
+		return false
+	}
+	if end < len(input) && isListWordByte(input[end]) && isListWordByte(input[end-1]) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,5 @@
+//go:build !race
+
+package veil
+
+const raceEnabled = false
@@ -1,6 +1,11 @@
 package veil
 
-import "github.com/veil-services/veil-go/detectors"
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/veil-services/veil-go/detectors"
+)
 
 // Option defines a function to configure Veil.
 type Option func(*Config)
@@ -33,6 +38,15 @@ func WithCreditCard() Option {
 	}
 }
 
+// WithCardCompanions enables masking of credit cards together with the
+// expiry dates (12/27) and CVV codes written near them, as required by PCI DSS.
+func WithCardCompanions() Option {
+	return func(c *Config) {
+		c.MaskCreditCard = true
+		c.MaskCardCompanions = true
+	}
+}
+
 // WithIP enables masking of IPv4 addresses.
 func WithIP() Option {
 	return func(c *Config) {
@@ -47,6 +61,19 @@ func WithPhone() Option {
 	}
 }
 
+// WithObfuscatedContacts enables masking of emails and phone numbers,
+// including forms written to dodge filters, in English and Portuguese:
+// "john at example dot com", "john[at]example[.]com", "maria (arroba)
+// empresa (ponto) com" and numbers spelled out or spaced one digit at a time
+// ("nove nove oito sete ..."). The whole obfuscated span is masked.
+func WithObfuscatedContacts() Option {
+	return func(c *Config) {
+		c.MaskEmail = true
+		c.MaskPhone = true
+		c.MaskObfuscated = true
+	}
+}
+
 // WithUUID enables masking of UUIDs/GUIDs.
 func WithUUID() Option {
 	return func(c *Config) {
@@ -54,6 +81,635 @@ func WithUUID() Option {
 	}
 }
 
+// WithMAC enables masking of MAC addresses (colon, dash and Cisco dotted formats).
+func WithMAC() Option {
+	return func(c *Config) {
+		c.MaskMAC = true
+	}
+}
+
+// WithIMEI enables masking of IMEI and IMEISV device numbers.
+func WithIMEI() Option {
+	return func(c *Config) {
+		c.MaskIMEI = true
+	}
+}
+
+// WithDeviceIDs enables masking of labelled advertising identifiers
+// (Apple IDFA/IDFV, Android AAID/GAID).
+func WithDeviceIDs() Option {
+	return func(c *Config) {
+		c.MaskDeviceID = true
+	}
+}
+
+// WithDeviceIdentifiers enables every device identifier detector:
+// MAC addresses, IMEIs and advertising IDs.
+func WithDeviceIdentifiers() Option {
+	return func(c *Config) {
+		c.MaskMAC = true
+		c.MaskIMEI = true
+		c.MaskDeviceID = true
+	}
+}
+
+// WithCUIT enables masking of CUIT/CUIL tax IDs (Argentina).
+func WithCUIT() Option {
+	return func(c *Config) {
+		c.MaskCUIT = true
+	}
+}
+
+// WithRUT enables masking of RUT/RUN numbers (Chile).
+func WithRUT() Option {
+	return func(c *Config) {
+		c.MaskRUT = true
+	}
+}
+
+// WithRFC enables masking of RFC tax IDs (Mexico).
+func WithRFC() Option {
+	return func(c *Config) {
+		c.MaskRFC = true
+	}
+}
+
+// WithCURP enables masking of CURP population registry codes (Mexico).
+func WithCURP() Option {
+	return func(c *Config) {
+		c.MaskCURP = true
+	}
+}
+
+// WithNIT enables masking of NIT tax IDs (Colombia).
+func WithNIT() Option {
+	return func(c *Config) {
+		c.MaskNIT = true
+	}
+}
+
+// WithRUC enables masking of RUC tax IDs (Peru).
+func WithRUC() Option {
+	return func(c *Config) {
+		c.MaskRUC = true
+	}
+}
+
+// WithNINO enables masking of UK National Insurance numbers.
+func WithNINO() Option {
+	return func(c *Config) {
+		c.MaskNINO = true
+	}
+}
+
+// WithNHS enables masking of UK NHS numbers.
+func WithNHS() Option {
+	return func(c *Config) {
+		c.MaskNHS = true
+	}
+}
+
+// WithDNI enables masking of DNI numbers (Spain).
+func WithDNI() Option {
+	return func(c *Config) {
+		c.MaskDNI = true
+	}
+}
+
+// WithNIE enables masking of NIE foreigner IDs (Spain).
+func WithNIE() Option {
+	return func(c *Config) {
+		c.MaskNIE = true
+	}
+}
+
+// WithCodiceFiscale enables masking of Codice Fiscale (Italy).
+func WithCodiceFiscale() Option {
+	return func(c *Config) {
+		c.MaskCodiceFiscale = true
+	}
+}
+
+// WithNIR enables masking of NIR social security numbers (France).
+func WithNIR() Option {
+	return func(c *Config) {
+		c.MaskNIR = true
+	}
+}
+
+// WithSteuerID enables masking of Steuer-ID tax numbers (Germany).
+func WithSteuerID() Option {
+	return func(c *Config) {
+		c.MaskSteuerID = true
+	}
+}
+
+// WithBSN enables masking of BSN citizen service numbers (Netherlands).
+func WithBSN() Option {
+	return func(c *Config) {
+		c.MaskBSN = true
+	}
+}
+
+// WithNIF enables masking of NIF tax numbers (Portugal).
+func WithNIF() Option {
+	return func(c *Config) {
+		c.MaskNIF = true
+	}
+}
+
+// WithEUIdentifiers enables every European national ID detector
+// (UK, Spain, Italy, France, Germany, Netherlands and Portugal).
+func WithEUIdentifiers() Option {
+	return func(c *Config) {
+		c.MaskNINO = true
+		c.MaskNHS = true
+		c.MaskDNI = true
+		c.MaskNIE = true
+		c.MaskCodiceFiscale = true
+		c.MaskNIR = true
+		c.MaskSteuerID = true
+		c.MaskBSN = true
+		c.MaskNIF = true
+	}
+}
+
+// WithAadhaar enables masking of Aadhaar numbers (India).
+func WithAadhaar() Option {
+	return func(c *Config) {
+		c.MaskAadhaar = true
+	}
+}
+
+// WithPAN enables masking of PAN card numbers (India).
+func WithPAN() Option {
+	return func(c *Config) {
+		c.MaskPAN = true
+	}
+}
+
+// WithNRIC enables masking of NRIC/FIN numbers (Singapore).
+func WithNRIC() Option {
+	return func(c *Config) {
+		c.MaskNRIC = true
+	}
+}
+
+// WithTFN enables masking of Tax File Numbers (Australia).
+func WithTFN() Option {
+	return func(c *Config) {
+		c.MaskTFN = true
+	}
+}
+
+// WithMedicare enables masking of Medicare card numbers (Australia).
+func WithMedicare() Option {
+	return func(c *Config) {
+		c.MaskMedicare = true
+	}
+}
+
+// WithBitcoin enables masking of Bitcoin addresses (Base58Check and bc1 SegWit).
+func WithBitcoin() Option {
+	return func(c *Config) {
+		c.MaskBitcoin = true
+	}
+}
+
+// WithEthereum enables masking of Ethereum addresses (EIP-55 checked when mixed-case).
+func WithEthereum() Option {
+	return func(c *Config) {
+		c.MaskEthereum = true
+	}
+}
+
+// WithSolana enables masking of Solana addresses. Solana addresses have no
+// checksum, so only values introduced by a label like "wallet" are masked.
+func WithSolana() Option {
+	return func(c *Config) {
+		c.MaskSolana = true
+	}
+}
+
+// WithTron enables masking of Tron addresses.
+func WithTron() Option {
+	return func(c *Config) {
+		c.MaskTron = true
+	}
+}
+
+// WithCryptoWallets enables every cryptocurrency wallet detector:
+// Bitcoin, Ethereum, Solana and Tron.
+func WithCryptoWallets() Option {
+	return func(c *Config) {
+		c.MaskBitcoin = true
+		c.MaskEthereum = true
+		c.MaskSolana = true
+		c.MaskTron = true
+	}
+}
+
+// WithURLs enables URL-aware masking: userinfo ("user:pass@"), the values of
+// sensitive query parameters (detectors.DefaultURLParams) and percent-encoded
+// path or query values in which another enabled detector finds PII.
+func WithURLs() Option {
+	return func(c *Config) {
+		c.MaskURL = true
+	}
+}
+
+// WithURLParams enables URL masking and adds query parameter names whose
+// values are always masked, on top of detectors.DefaultURLParams.
+// e.g. WithURLParams("customer_id", "cpf")
+func WithURLParams(names ...string) Option {
+	return func(c *Config) {
+		c.MaskURL = true
+		c.URLParams = append(c.URLParams, names...)
+	}
+}
+
+// WithDSN enables masking of passwords in database connection strings
+// (postgres://, mongodb+srv://, redis://, JDBC and ADO.NET strings).
+func WithDSN() Option {
+	return func(c *Config) {
+		c.MaskDSN = true
+	}
+}
+
+// WithDSNUsers also masks the user name of connection strings.
+// It implies WithDSN.
+func WithDSNUsers() Option {
+	return func(c *Config) {
+		c.MaskDSN = true
+		c.MaskDSNUser = true
+	}
+}
+
+// WithDSNHosts also masks the host name(s) of connection strings.
+// It implies WithDSN.
+func WithDSNHosts() Option {
+	return func(c *Config) {
+		c.MaskDSN = true
+		c.MaskDSNHost = true
+	}
+}
+
+// WithDateOfBirth enables masking of birth dates: dates introduced by a
+// keyword such as "DOB", "born" or "nascimento".
+func WithDateOfBirth() Option {
+	return func(c *Config) {
+		c.MaskDOB = true
+	}
+}
+
+// WithAllDates masks every date, not only birth dates. Dates without a birth
+// keyword are reported as DATE.
+func WithAllDates() Option {
+	return func(c *Config) {
+		c.MaskDOB = true
+		c.MaskAllDates = true
+	}
+}
+
+// WithDateLocale sets how numeric dates are read from a locale such as
+// "en-US" (month first) or "pt-BR" (day first, the default).
+func WithDateLocale(locale string) Option {
+	return func(c *Config) {
+		c.DateOrder = detectors.DateOrderForLocale(locale)
+	}
+}
+
+// WithCoordinates enables masking of GPS coordinates (decimal pairs, DMS,
+// geo: URIs and lat=/lng= values).
+func WithCoordinates() Option {
+	return func(c *Config) {
+		c.MaskCoordinates = true
+	}
+}
+
+// WithCoarseCoordinates masks GPS coordinates by rounding them to 2 decimals
+// (~1 km) instead of replacing them with a token, so the area remains usable.
+// Coarsened coordinates cannot be restored.
+func WithCoarseCoordinates() Option {
+	return func(c *Config) {
+		c.MaskCoordinates = true
+		c.CoarsenCoordinates = true
+	}
+}
+
+// WithLicensePlate enables masking of license plates (Brazil, Mercosul and
+// legacy layouts).
+func WithLicensePlate() Option {
+	return func(c *Config) {
+		c.MaskLicensePlate = true
+	}
+}
+
+// WithVIN enables masking of Vehicle Identification Numbers (ISO 3779).
+func WithVIN() Option {
+	return func(c *Config) {
+		c.MaskVIN = true
+	}
+}
+
+// WithRENAVAM enables masking of RENAVAM vehicle registry numbers (Brazil).
+func WithRENAVAM() Option {
+	return func(c *Config) {
+		c.MaskRENAVAM = true
+	}
+}
+
+// WithVehicleIdentifiers enables every vehicle detector: license plates,
+// VINs and RENAVAM numbers.
+func WithVehicleIdentifiers() Option {
+	return func(c *Config) {
+		c.MaskLicensePlate = true
+		c.MaskVIN = true
+		c.MaskRENAVAM = true
+	}
+}
+
+// WithNPI enables masking of National Provider Identifiers (US) that follow
+// an "NPI" keyword.
+func WithNPI() Option {
+	return func(c *Config) {
+		c.MaskNPI = true
+	}
+}
+
+// WithDEA enables masking of DEA registration numbers (US).
+func WithDEA() Option {
+	return func(c *Config) {
+		c.MaskDEA = true
+	}
+}
+
+// WithMRN enables masking of medical record numbers that follow a keyword
+// ("MRN", "medical record", "prontuário"), plus any of the given
+// institution formats, where '#' is a digit, '@' a letter and '*' either:
+//
+//	veil.WithMRN("MR-#######", "@@######")
+func WithMRN(patterns ...string) Option {
+	return func(c *Config) {
+		c.MaskMRN = true
+		c.MRNPatterns = append(c.MRNPatterns, patterns...)
+	}
+}
+
+// WithICD10 enables masking of ICD-10 diagnosis codes (E11.9) near a patient
+// or diagnosis keyword.
+func WithICD10() Option {
+	return func(c *Config) {
+		c.MaskICD10 = true
+	}
+}
+
+// WithHealthIdentifiers enables the health identifier detectors: NPI, DEA
+// and medical record numbers. ICD-10 codes stay opt-in (WithICD10).
+func WithHealthIdentifiers() Option {
+	return func(c *Config) {
+		c.MaskNPI = true
+		c.MaskDEA = true
+		c.MaskMRN = true
+	}
+}
+
+// WithHIPAASafeHarbor enables every detector that covers a HIPAA Safe Harbor
+// identifier: names, street addresses and ZIP codes, coordinates, all dates,
+// phone numbers, emails, URLs, IP addresses, medical record numbers, card
+// numbers, vehicle and device identifiers, UUIDs, plus provider NPI and DEA
+// numbers. Identifiers without a detector (e.g. SSNs, health plan numbers)
+// are not covered and need custom detectors.
+func WithHIPAASafeHarbor() Option {
+	return func(c *Config) {
+		c.MaskName = true
+		c.MaskStreetAddress = true
+		c.MaskZIP = true
+		c.MaskCoordinates = true
+		c.MaskDOB = true
+		c.MaskAllDates = true
+		c.MaskPhone = true
+		c.MaskEmail = true
+		c.MaskURL = true
+		c.MaskIP = true
+		c.MaskMRN = true
+		c.MaskCreditCard = true
+		c.MaskVIN = true
+		c.MaskLicensePlate = true
+		c.MaskMAC = true
+		c.MaskIMEI = true
+		c.MaskDeviceID = true
+		c.MaskUUID = true
+		c.MaskNPI = true
+		c.MaskDEA = true
+	}
+}
+
+// WithCEP enables masking of CEP postal codes (Brazil).
+func WithCEP() Option {
+	return func(c *Config) {
+		c.MaskCEP = true
+	}
+}
+
+// WithZIP enables masking of ZIP and ZIP+4 codes (US) that follow a state
+// abbreviation or a "ZIP" keyword.
+func WithZIP() Option {
+	return func(c *Config) {
+		c.MaskZIP = true
+	}
+}
+
+// WithUKPostcode enables masking of UK postcodes.
+func WithUKPostcode() Option {
+	return func(c *Config) {
+		c.MaskUKPostcode = true
+	}
+}
+
+// WithCAPostalCode enables masking of postal codes (Canada).
+func WithCAPostalCode() Option {
+	return func(c *Config) {
+		c.MaskCAPostalCode = true
+	}
+}
+
+// WithStreetAddress enables the street address heuristic
+// ("Rua Augusta, 1500", "221B Baker St").
+func WithStreetAddress() Option {
+	return func(c *Config) {
+		c.MaskStreetAddress = true
+	}
+}
+
+// WithAddresses enables every postal code detector and the street address
+// heuristic.
+func WithAddresses() Option {
+	return func(c *Config) {
+		c.MaskCEP = true
+		c.MaskZIP = true
+		c.MaskUKPostcode = true
+		c.MaskCAPostalCode = true
+		c.MaskStreetAddress = true
+	}
+}
+
+// WithNames enables masking of person names, found with the bundled
+// first-name/surname dictionaries and honorific cues ("Sr.", "Dr.", "Dear").
+func WithNames() Option {
+	return func(c *Config) {
+		c.MaskName = true
+	}
+}
+
+// WithNameMinScore sets the score (0-1) a candidate name needs to be masked.
+// Lower values catch more names at the cost of false positives.
+func WithNameMinScore(score float32) Option {
+	return func(c *Config) {
+		c.MaskName = true
+		c.NameMinScore = score
+	}
+}
+
+// WithCustomNames adds first names and surnames to the bundled dictionaries,
+// e.g. loaded with detectors.ParseNameList.
+func WithCustomNames(firstNames, surnames []string) Option {
+	return func(c *Config) {
+		c.MaskName = true
+		c.NameFirstNames = append(c.NameFirstNames, firstNames...)
+		c.NameSurnames = append(c.NameSurnames, surnames...)
+	}
+}
+
+// WithUnicodeNormalization makes detectors see full-width digits and
+// punctuation (１１１.４４４), Arabic-Indic and other script digits, NBSP and
+// thin spaces and dash variants as their ASCII equivalents. Matches, tokens
+// and restored values still refer to the original text.
+func WithUnicodeNormalization() Option {
+	return func(c *Config) {
+		c.NormalizeUnicode = true
+	}
+}
+
+// WithEvasionResistance strips invisible characters (zero-width spaces and
+// joiners, soft hyphens, bidi controls) and folds Cyrillic/Greek look-alikes
+// to Latin before detection, so "4111\u200b1111..." or "ex\u0430mple.com"
+// are still found. The masked span covers the original, obfuscated text.
+func WithEvasionResistance() Option {
+	return func(c *Config) {
+		c.FoldEvasions = true
+	}
+}
+
+// WithAllow keeps the given values unmasked when a detector reports them as
+// typ, e.g. the support email or a documentation test card. Use AnyType to
+// allow a value whatever its type.
+//
+// Numeric identifiers (CPF, CNPJ, cards, phones, ...) are compared by their
+// digits, so "4242 4242 4242 4242" also allows "4242-4242-4242-4242";
+// emails and hex identifiers ignore case. IP entries may be CIDR ranges
+// such as "10.0.0.0/8".
+func WithAllow(typ detectors.PIIType, values ...string) Option {
+	return func(c *Config) {
+		if c.Allow == nil {
+			c.Allow = make(map[detectors.PIIType][]string)
+		}
+		c.Allow[typ] = append(c.Allow[typ], values...)
+	}
+}
+
+// WithDeny always masks the given values as typ, even where no detector
+// finds them (e.g. internal project names). Values are normalized as in
+// WithAllow: a denied CPF is found whatever its punctuation, and an IP
+// range masks every IPv4 address inside it.
+func WithDeny(typ detectors.PIIType, values ...string) Option {
+	return func(c *Config) {
+		if typ == "" || typ == AnyType {
+			c.setErr(fmt.Errorf("veil: deny list needs a concrete type, got %q", typ))
+			return
+		}
+		if c.Deny == nil {
+			c.Deny = make(map[detectors.PIIType][]string)
+		}
+		c.Deny[typ] = append(c.Deny[typ], values...)
+	}
+}
+
+// WithAllowPattern keeps matches of typ unmasked when the whole value
+// matches expr, e.g. WithAllowPattern(detectors.TypeEmail, `.+@example\.com`).
+// Use AnyType for every type. An invalid expression makes New fail.
+func WithAllowPattern(typ detectors.PIIType, expr string) Option {
+	return func(c *Config) {
+		re, err := regexp.Compile(`^(?:` + expr + `)$`)
+		if err != nil {
+			c.setErr(fmt.Errorf("veil: allow pattern: %w", err))
+			return
+		}
+		if c.AllowPatterns == nil {
+			c.AllowPatterns = make(map[detectors.PIIType][]*regexp.Regexp)
+		}
+		c.AllowPatterns[typ] = append(c.AllowPatterns[typ], re)
+	}
+}
+
+// WithDenyPattern always masks the matches of expr as typ. An invalid
+// expression makes New fail.
+func WithDenyPattern(typ detectors.PIIType, expr string) Option {
+	return func(c *Config) {
+		if typ == "" || typ == AnyType {
+			c.setErr(fmt.Errorf("veil: deny pattern needs a concrete type, got %q", typ))
+			return
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			c.setErr(fmt.Errorf("veil: deny pattern: %w", err))
+			return
+		}
+		if c.DenyPatterns == nil {
+			c.DenyPatterns = make(map[detectors.PIIType][]*regexp.Regexp)
+		}
+		c.DenyPatterns[typ] = append(c.DenyPatterns[typ], re)
+	}
+}
+
+// WithOverlapStrategy sets which finding wins when detections overlap:
+// OverlapLeftmost (default), OverlapLongest, OverlapScore, OverlapPriority
+// or OverlapMerge.
+func WithOverlapStrategy(s OverlapStrategy) Option {
+	return func(c *Config) {
+		if _, ok := overlapNames[s]; !ok {
+			c.setErr(fmt.Errorf("veil: unknown overlap strategy %d", s))
+			return
+		}
+		c.Overlap = s
+	}
+}
+
+// WithTypePriority resolves overlaps by type, first listed first, e.g.
+// WithTypePriority(detectors.TypeCNPJ, detectors.TypeCreditCard) keeps a
+// CNPJ that also reads as a card number. It implies OverlapPriority.
+func WithTypePriority(types ...detectors.PIIType) Option {
+	return func(c *Config) {
+		c.Overlap = OverlapPriority
+		c.TypePriority = append(c.TypePriority, types...)
+	}
+}
+
+// WithStrategy sets how matches of typ are written in the masked text, e.g.
+// WithStrategy(detectors.TypeCreditCard, StrategyPartial).
+func WithStrategy(typ detectors.PIIType, s Strategy) Option {
+	return func(c *Config) {
+		if !s.valid() {
+			c.setErr(fmt.Errorf("veil: unknown masking strategy %q", s))
+			return
+		}
+		if c.Strategies == nil {
+			c.Strategies = make(map[detectors.PIIType]Strategy)
+		}
+		c.Strategies[typ] = s
+	}
+}
+
 // WithConsistentTokenization ensures that the same original value receives the same token
 // during the masking process of a single string.
 // e.g. "john@a.com ... john@a.com" -> "<<EMAIL_1>> ... <<EMAIL_1>>"
@@ -63,9 +719,91 @@ func WithConsistentTokenization(enabled bool) Option {
 	}
 }
 
+// WithDetectors enables detectors by name, as listed by
+// RegisteredDetectors: built-in ones ("br_cpf", "global_ipv4") and those
+// added with Register. An unknown name makes New fail.
+//
+//	veil.New(veil.WithDetectors("email", "br_cpf", "global_ipv4"))
+func WithDetectors(names ...string) Option {
+	return func(c *Config) {
+		c.enabled = append(c.enabled, names...)
+	}
+}
+
+// WithLocales enables every registered detector tagged with one of the
+// locales: countries ("br", "us", "uk"), regions ("eu", "latam", "apac")
+// or "global" for detectors that apply everywhere.
+//
+//	veil.New(veil.WithLocales("global", "br"))
+func WithLocales(locales ...string) Option {
+	return func(c *Config) {
+		c.locales = append(c.locales, locales...)
+	}
+}
+
+// WithoutDetector removes detectors by name, whatever enabled them, e.g.
+// WithEUIdentifiers() and WithoutDetector("uk_nhs").
+func WithoutDetector(names ...string) Option {
+	return func(c *Config) {
+		if c.disabled == nil {
+			c.disabled = make(map[string]bool)
+		}
+		for _, name := range names {
+			c.disabled[name] = true
+		}
+	}
+}
+
+// WithRegistry resolves WithDetectors and WithLocales through r instead of
+// the default registry, for detectors registered on this instance only.
+func WithRegistry(r *Registry) Option {
+	return func(c *Config) {
+		c.registry = r
+	}
+}
+
 // WithCustomDetector adds a user-defined detector to the list.
 func WithCustomDetector(d detectors.Detector) Option {
 	return func(c *Config) {
 		c.CustomDetectors = append(c.CustomDetectors, d)
 	}
 }
+
+// WithPattern adds a regular expression detector built with
+// detectors.NewPattern. Matches are tokenized with typ, e.g.
+// detectors.NewPIIType("account_id") gives <<ACCOUNT_ID_N>>. An invalid
+// expression or option makes New fail.
+//
+//	veil.WithPattern("account_id", detectors.NewPIIType("account_id"), `ACC-\d{4}-\d{6}`)
+func WithPattern(name string, typ detectors.PIIType, expr string, opts ...detectors.PatternOption) Option {
+	return func(c *Config) {
+		d, err := detectors.NewPattern(name, typ, expr, opts...)
+		if err != nil {
+			c.setErr(err)
+			return
+		}
+		c.CustomDetectors = append(c.CustomDetectors, d)
+	}
+}
+
+// WithDictionary adds a word-list detector built with
+// detectors.NewDictionary: thousands of terms (customer names, project
+// codenames) are found in one pass, ignoring case and accents by default.
+// To change the list at runtime, build the detector yourself, add it with
+// WithCustomDetector and call its Update method.
+//
+//	veil.WithDictionary("projects", detectors.NewPIIType("project"),
+//		detectors.DictionaryTerms("Falcon", "Osprey"))
+func WithDictionary(name string, typ detectors.PIIType, entries []detectors.DictionaryEntry, opts ...detectors.DictionaryOption) Option {
+	d := detectors.NewDictionary(name, typ, entries, opts...)
+	return func(c *Config) {
+		c.CustomDetectors = append(c.CustomDetectors, d)
+	}
+}
+
+// setErr records the first option error.
+func (c *Config) setErr(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
@@ -0,0 +1,263 @@
+package veil
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/veil-services/veil-go/detectors"
+)
+
+// OverlapStrategy tells which finding wins when detections overlap, e.g. a
+// 14-digit number read both as a CNPJ and as a card.
+type OverlapStrategy uint8
+
+const (
+	// OverlapLeftmost keeps the match starting first, then the longest, then
+	// the highest score (default). It suits matches nested in others, such
+	// as the credentials of a URL.
+	OverlapLeftmost OverlapStrategy = iota
+	// OverlapLongest keeps the longest match, then the highest score, then
+	// the earliest one.
+	OverlapLongest
+	// OverlapScore keeps the highest score, then the longest match.
+	OverlapScore
+	// OverlapPriority keeps the type listed first by WithTypePriority, then
+	// the longest match. Types not listed come after those listed.
+	OverlapPriority
+	// OverlapMerge merges overlapping matches into one span covering all
+	// of them. The finding takes the type of the longest match and lists
+	// every type in Metadata["types"].
+	OverlapMerge
+)
+
+var overlapNames = map[OverlapStrategy]string{
+	OverlapLeftmost: "leftmost",
+	OverlapLongest:  "longest",
+	OverlapScore:    "score",
+	OverlapPriority: "priority",
+	OverlapMerge:    "merge",
+}
+
+func (s OverlapStrategy) String() string {
+	if name, ok := overlapNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseOverlapStrategy returns the strategy called name ("leftmost",
+// "longest", "score", "priority" or "merge").
+func ParseOverlapStrategy(name string) (OverlapStrategy, bool) {
+	for s, n := range overlapNames {
+		if strings.EqualFold(n, name) {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// Metadata keys set on findings that won or absorbed an overlap.
+const (
+	// MetaOverlap is the strategy that resolved the overlap.
+	MetaOverlap = "overlap"
+	// MetaOverlapped lists the types of the discarded matches, comma-separated.
+	MetaOverlapped = "overlapped"
+	// MetaTypes lists the types of a merged finding, comma-separated.
+	MetaTypes = "types"
+)
+
+// overlapResolver picks findings among overlapping matches.
+type overlapResolver struct {
+	strategy OverlapStrategy
+	priority map[detectors.PIIType]int
+}
+
+func newOverlapResolver(strategy OverlapStrategy, priority []detectors.PIIType) overlapResolver {
+	r := overlapResolver{strategy: strategy}
+	if len(priority) > 0 {
+		r.priority = make(map[detectors.PIIType]int, len(priority))
+		for i, typ := range priority {
+			if _, ok := r.priority[typ]; !ok {
+				r.priority[typ] = i
+			}
+		}
+	}
+	return r
+}
+
+// better reports whether a wins over b.
+func (r *overlapResolver) better(a, b *detectors.Match) bool {
+	switch r.strategy {
+	case OverlapLeftmost:
+		if a.StartIndex != b.StartIndex {
+			return a.StartIndex < b.StartIndex
+		}
+	case OverlapScore:
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+	case OverlapPriority:
+		if pa, pb := r.rank(a.Type), r.rank(b.Type); pa != pb {
+			return pa < pb
+		}
+	}
+	if la, lb := a.EndIndex-a.StartIndex, b.EndIndex-b.StartIndex; la != lb {
+		return la > lb
+	}
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.StartIndex < b.StartIndex
+}
+
+func (r *overlapResolver) rank(typ detectors.PIIType) int {
+	if p, ok := r.priority[typ]; ok {
+		return p
+	}
+	return len(r.priority)
+}
+
+// resolve returns non-overlapping findings sorted by position. Findings
+// involved in an overlap record it in their metadata. The result reuses the
+// memory of matches.
+func (r *overlapResolver) resolve(input string, matches []detectors.Match) []detectors.Match {
+	if len(matches) <= 1 {
+		return matches
+	}
+
+	// Best first; detector order breaks remaining ties
+	slices.SortStableFunc(matches, func(a, b detectors.Match) int {
+		if r.better(&a, &b) {
+			return -1
+		}
+		if r.better(&b, &a) {
+			return 1
+		}
+		return 0
+	})
+
+	if r.strategy == OverlapMerge {
+		return r.merge(input, matches)
+	}
+
+	// kept is built in place at the front of matches; it stays sorted by
+	// position and, being disjoint, its ends are sorted too. It never grows
+	// past the match being read.
+	kept := matches[:0]
+	var dropped map[int][]detectors.PIIType // types beaten, by winner start
+	for i := range matches {
+		m := matches[i]
+		k := sort.Search(len(kept), func(k int) bool { return kept[k].EndIndex > m.StartIndex })
+		if k < len(kept) && kept[k].StartIndex < m.EndIndex {
+			w := &kept[k]
+			// Several detectors reporting the same finding is not a conflict
+			if m.Type != w.Type || m.StartIndex != w.StartIndex || m.EndIndex != w.EndIndex {
+				if dropped == nil {
+					dropped = make(map[int][]detectors.PIIType)
+				}
+				dropped[w.StartIndex] = appendType(dropped[w.StartIndex], m.Type)
+			}
+			continue
+		}
+		kept = append(kept, detectors.Match{})
+		copy(kept[k+1:], kept[k:])
+		kept[k] = m
+	}
+
+	for i := range kept {
+		if types, ok := dropped[kept[i].StartIndex]; ok {
+			kept[i].Metadata = withMetadata(kept[i].Metadata,
+				MetaOverlap, r.strategy.String(),
+				MetaOverlapped, joinTypes(types))
+		}
+	}
+	return kept
+}
+
+// merge joins groups of overlapping matches into one finding each. matches
+// are sorted best first.
+func (r *overlapResolver) merge(input string, matches []detectors.Match) []detectors.Match {
+	// Rank of each match, to pick the best of a group after sorting by start
+	rank := make(map[*detectors.Match]int, len(matches))
+	byStart := make([]*detectors.Match, len(matches))
+	for i := range matches {
+		rank[&matches[i]] = i
+		byStart[i] = &matches[i]
+	}
+	sort.SliceStable(byStart, func(i, j int) bool {
+		return byStart[i].StartIndex < byStart[j].StartIndex
+	})
+
+	var result []detectors.Match
+	for i := 0; i < len(byStart); {
+		group := []*detectors.Match{byStart[i]}
+		end := byStart[i].EndIndex
+		j := i + 1
+		for ; j < len(byStart) && byStart[j].StartIndex < end; j++ {
+			group = append(group, byStart[j])
+			if byStart[j].EndIndex > end {
+				end = byStart[j].EndIndex
+			}
+		}
+		i = j
+
+		if len(group) == 1 {
+			result = append(result, *group[0])
+			continue
+		}
+
+		sort.Slice(group, func(a, b int) bool { return rank[group[a]] < rank[group[b]] })
+		merged := *group[0]
+		var types []detectors.PIIType
+		for _, m := range group {
+			if m.StartIndex < merged.StartIndex {
+				merged.StartIndex = m.StartIndex
+			}
+			if m.Score > merged.Score {
+				merged.Score = m.Score
+			}
+			types = appendType(types, m.Type)
+		}
+		merged.EndIndex = end
+		merged.Value = input[merged.StartIndex:merged.EndIndex]
+		merged.Replacement = ""
+		if len(types) > 1 {
+			merged.Metadata = withMetadata(merged.Metadata,
+				MetaOverlap, r.strategy.String(),
+				MetaTypes, joinTypes(types))
+		}
+		result = append(result, merged)
+	}
+	return result
+}
+
+func appendType(types []detectors.PIIType, typ detectors.PIIType) []detectors.PIIType {
+	for _, t := range types {
+		if t == typ {
+			return types
+		}
+	}
+	return append(types, typ)
+}
+
+func joinTypes(types []detectors.PIIType) string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		s[i] = string(t)
+	}
+	return strings.Join(s, ",")
+}
+
+// withMetadata returns a copy of meta with the given key/value pairs, since
+// detectors may share their metadata maps.
+func withMetadata(meta map[string]string, kv ...string) map[string]string {
+	out := make(map[string]string, len(meta)+len(kv)/2)
+	for k, v := range meta {
+		out[k] = v
+	}
+	for i := 0; i+1 < len(kv); i += 2 {
+		out[kv[i]] = kv[i+1]
+	}
+	return out
+}
@@ -0,0 +1,141 @@
+package veil
+
+import (
+	"testing"
+
+	"github.com/veil-services/veil-go/detectors"
+)
+
+// fixedDetector reports the given spans of its input.
+type fixedDetector struct {
+	name    string
+	typ     detectors.PIIType
+	score   float32
+	matches [][2]int
+}
+
+func (d fixedDetector) Name() string { return d.name }
+
+func (d fixedDetector) Scan(input string) []detectors.Match {
+	var out []detectors.Match
+	for _, span := range d.matches {
+		out = append(out, detectors.Match{
+			StartIndex: span[0],
+			EndIndex:   span[1],
+			Value:      input[span[0]:span[1]],
+			Type:       d.typ,
+			Score:      d.score,
+		})
+	}
+	return out
+}
+
+func TestVeil_OverlapStrategies(t *testing.T) {
+	//         0         1         2
+	//         0123456789012345678901234
+	input := "id 12345678901234 tail"
+
+	// A short high-score match starting first, a longer one and a nested one
+	phone := fixedDetector{"phone", detectors.TypePhone, 0.6, [][2]int{{3, 17}}}
+	card := fixedDetector{"card", detectors.TypeCreditCard, 0.9, [][2]int{{5, 17}}}
+	cnpj := fixedDetector{"cnpj", detectors.TypeCNPJ, 1.0, [][2]int{{3, 12}}}
+	opts := []Option{WithCustomDetector(cnpj), WithCustomDetector(card), WithCustomDetector(phone)}
+
+	tests := []struct {
+		name       string
+		strategy   Option
+		typ        detectors.PIIType
+		value      string
+		overlapped string
+	}{
+		{"Leftmost", WithOverlapStrategy(OverlapLeftmost), detectors.TypePhone, "12345678901234", "CNPJ,CREDIT_CARD"},
+		{"Longest", WithOverlapStrategy(OverlapLongest), detectors.TypePhone, "12345678901234", "CREDIT_CARD,CNPJ"},
+		{"Score", WithOverlapStrategy(OverlapScore), detectors.TypeCNPJ, "123456789", "CREDIT_CARD,PHONE"},
+		{"Priority", WithTypePriority(detectors.TypeCreditCard, detectors.TypeCNPJ), detectors.TypeCreditCard, "345678901234", "CNPJ,PHONE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := New(append(opts, tt.strategy)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			found := v.Detect(input)
+			if len(found) == 0 || found[0].Type != tt.typ || found[0].Value != tt.value {
+				t.Fatalf("expected %s %q first, got %+v", tt.typ, tt.value, found)
+			}
+			m := found[0]
+			if m.Metadata[MetaOverlapped] != tt.overlapped || m.Metadata[MetaOverlap] == "" {
+				t.Errorf("expected overlapped %q, got %v", tt.overlapped, m.Metadata)
+			}
+			for i := 1; i < len(found); i++ {
+				if found[i].StartIndex < found[i-1].EndIndex {
+					t.Errorf("findings overlap: %+v", found)
+				}
+			}
+		})
+	}
+
+	// Score: both longer matches overlap the CNPJ and are dropped
+	v, _ := New(append(opts, WithOverlapStrategy(OverlapScore))...)
+	if found := v.Detect(input); len(found) != 1 {
+		t.Errorf("expected the CNPJ alone, got %+v", found)
+	}
+}
+
+func TestVeil_OverlapMerge(t *testing.T) {
+	input := "id 12345678901234 and 555"
+	v, _ := New(
+		WithCustomDetector(fixedDetector{"cnpj", detectors.TypeCNPJ, 1.0, [][2]int{{3, 12}}}),
+		WithCustomDetector(fixedDetector{"card", detectors.TypeCreditCard, 0.9, [][2]int{{5, 17}, {22, 25}}}),
+		WithOverlapStrategy(OverlapMerge),
+	)
+
+	found := v.Detect(input)
+	if len(found) != 2 {
+		t.Fatalf("expected 2 findings, got %+v", found)
+	}
+	m := found[0]
+	if m.Value != "12345678901234" || m.Type != detectors.TypeCreditCard || m.Score != 1.0 {
+		t.Errorf("unexpected merged finding: %+v", m)
+	}
+	if m.Metadata[MetaTypes] != "CREDIT_CARD,CNPJ" || m.Metadata[MetaOverlap] != "merge" {
+		t.Errorf("unexpected merge metadata: %v", m.Metadata)
+	}
+	if found[1].Value != "555" || found[1].Metadata != nil {
+		t.Errorf("lone match should be untouched: %+v", found[1])
+	}
+
+	masked, ctx, _ := v.Mask(input)
+	if masked != "id <<CREDIT_CARD_1>> and <<CREDIT_CARD_2>>" {
+		t.Errorf("Unexpected mask: %s", masked)
+	}
+	if restored, _ := v.Restore(masked, ctx); restored != input {
+		t.Errorf("Restore failed: %s", restored)
+	}
+}
+
+func TestVeil_OverlapScoreBreaksTies(t *testing.T) {
+	input := "ref 123456"
+	v, _ := New(
+		WithCustomDetector(fixedDetector{"low", "LOW", 0.4, [][2]int{{4, 10}}}),
+		WithCustomDetector(fixedDetector{"high", "HIGH", 0.8, [][2]int{{4, 10}}}),
+	)
+	if found := v.Detect(input); len(found) != 1 || found[0].Type != "HIGH" {
+		t.Errorf("expected the higher score to win a tie, got %+v", found)
+	}
+}
+
+func TestParseOverlapStrategy(t *testing.T) {
+	for _, s := range []OverlapStrategy{OverlapLeftmost, OverlapLongest, OverlapScore, OverlapPriority, OverlapMerge} {
+		if got, ok := ParseOverlapStrategy(s.String()); !ok || got != s {
+			t.Errorf("%s did not round-trip", s)
+		}
+	}
+	if _, ok := ParseOverlapStrategy("random"); ok {
+		t.Error("expected an unknown strategy")
+	}
+	if _, err := New(WithOverlapStrategy(OverlapStrategy(42))); err == nil {
+		t.Error("expected an error for an invalid strategy")
+	}
+}
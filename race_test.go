@@ -0,0 +1,7 @@
+//go:build race
+
+package veil
+
+// raceEnabled reports whether tests run with the race detector, which
+// makes sync.Pool drop items and so breaks allocation counts.
+const raceEnabled = true
@@ -0,0 +1,248 @@
+package veil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/veil-services/veil-go/detectors"
+)
+
+// DetectorInfo describes a detector known to a Registry.
+type DetectorInfo struct {
+	// Name is the Detector.Name of the detector, e.g. "br_cpf".
+	Name string
+	// Type is the main type it reports.
+	Type detectors.PIIType
+	// Locales are lower-case country codes ("br", "us") and regions
+	// ("eu", "latam", "apac"), or "global" for language-neutral detectors.
+	Locales     []string
+	Description string
+	Version     string
+}
+
+// Registry maps detector names to detectors, so they can be enabled by
+// name (WithDetectors, config files) or by locale (WithLocales).
+//
+// The default registry holds the built-in detectors and those added with
+// Register. A Registry from NewRegistry starts as a copy of it and is
+// used by one instance through WithRegistry.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[string]registration
+}
+
+type registration struct {
+	info DetectorInfo
+	// enable turns on a built-in detector through its Config flag
+	enable Option
+	// build creates a third-party detector
+	build func() detectors.Detector
+}
+
+// builtinVersion is the version reported by built-in detectors.
+const builtinVersion = "1.0"
+
+var defaultRegistry = newBuiltinRegistry()
+
+// NewRegistry returns a registry holding the detectors of the default
+// registry at the time of the call.
+func NewRegistry() *Registry {
+	defaultRegistry.mu.RLock()
+	defer defaultRegistry.mu.RUnlock()
+
+	r := &Registry{entries: make(map[string]registration, len(defaultRegistry.entries))}
+	for name, reg := range defaultRegistry.entries {
+		r.entries[name] = reg
+	}
+	return r
+}
+
+// Register adds a detector to the default registry. Third-party packages
+// usually call it from init:
+//
+//	func init() {
+//		veil.Register(veil.DetectorInfo{Name: "acme_badge", Type: "BADGE", Locales: []string{"global"}},
+//			func() detectors.Detector { return NewBadgeDetector() })
+//	}
+func Register(info DetectorInfo, build func() detectors.Detector) error {
+	return defaultRegistry.Register(info, build)
+}
+
+// RegisteredDetectors lists the detectors of the default registry, sorted
+// by name.
+func RegisteredDetectors() []DetectorInfo {
+	return defaultRegistry.Detectors()
+}
+
+// LookupDetector returns the description of a detector of the default
+// registry.
+func LookupDetector(name string) (DetectorInfo, bool) {
+	return defaultRegistry.Lookup(name)
+}
+
+// Register adds a detector built by build. Names are unique.
+func (r *Registry) Register(info DetectorInfo, build func() detectors.Detector) error {
+	if info.Name == "" {
+		return fmt.Errorf("veil: detector registration needs a name")
+	}
+	if build == nil {
+		return fmt.Errorf("veil: detector %q: nil constructor", info.Name)
+	}
+	info.Locales = lowerAll(info.Locales)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.entries[info.Name]; ok {
+		return fmt.Errorf("veil: detector %q is already registered", info.Name)
+	}
+	r.entries[info.Name] = registration{info: info, build: build}
+	return nil
+}
+
+// Lookup returns the description of a detector.
+func (r *Registry) Lookup(name string) (DetectorInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	reg, ok := r.entries[name]
+	return reg.info, ok
+}
+
+// Detectors lists the registered detectors, sorted by name.
+func (r *Registry) Detectors() []DetectorInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	infos := make([]DetectorInfo, 0, len(r.entries))
+	for _, reg := range r.entries {
+		infos = append(infos, reg.info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// enable applies the detector called name to c.
+func (r *Registry) enable(c *Config, name string) error {
+	r.mu.RLock()
+	reg, ok := r.entries[name]
+	r.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("veil: unknown detector %q", name)
+	}
+	if reg.enable != nil {
+		reg.enable(c)
+	} else {
+		c.CustomDetectors = append(c.CustomDetectors, reg.build())
+	}
+	return nil
+}
+
+// enableLocale applies every detector tagged with locale to c.
+func (r *Registry) enableLocale(c *Config, locale string) error {
+	locale = strings.ToLower(locale)
+	found := false
+	for _, info := range r.Detectors() {
+		for _, l := range info.Locales {
+			if l == locale {
+				if err := r.enable(c, info.Name); err != nil {
+					return err
+				}
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("veil: no detectors for locale %q", locale)
+	}
+	return nil
+}
+
+func lowerAll(values []string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strings.ToLower(v)
+	}
+	return out
+}
+
+func newBuiltinRegistry() *Registry {
+	builtins := []struct {
+		name        string
+		typ         detectors.PIIType
+		description string
+		locales     []string
+		enable      Option
+	}{
+		{"email", detectors.TypeEmail, "Email addresses, including internationalized ones", []string{"global"}, WithEmail()},
+		{"global_credit_card", detectors.TypeCreditCard, "Card numbers with IIN range, length and Luhn checks", []string{"global"}, WithCreditCard()},
+		{"global_ipv4", detectors.TypeIP, "IPv4 addresses", []string{"global"}, WithIP()},
+		{"global_phone_e164", detectors.TypePhone, "International phone numbers (E.164)", []string{"global"}, WithPhone()},
+		{"global_uuid", detectors.TypeUUID, "UUIDs", []string{"global"}, WithUUID()},
+		{"global_mac_address", detectors.TypeMAC, "MAC addresses", []string{"global"}, WithMAC()},
+		{"global_imei", detectors.TypeIMEI, "IMEI and IMEISV numbers", []string{"global"}, WithIMEI()},
+		{"global_device_id", detectors.TypeDeviceID, "Advertising IDs (IDFA, IDFV, AAID)", []string{"global"}, WithDeviceIDs()},
+		{"global_vin", detectors.TypeVIN, "Vehicle identification numbers (ISO 3779)", []string{"global"}, WithVIN()},
+		{"crypto_bitcoin", detectors.TypeBitcoinAddress, "Bitcoin addresses (Base58Check, Bech32)", []string{"global"}, WithBitcoin()},
+		{"crypto_ethereum", detectors.TypeEthereumAddress, "Ethereum addresses (EIP-55)", []string{"global"}, WithEthereum()},
+		{"crypto_solana", detectors.TypeSolanaAddress, "Labelled Solana addresses", []string{"global"}, WithSolana()},
+		{"crypto_tron", detectors.TypeTronAddress, "Tron addresses", []string{"global"}, WithTron()},
+		{"url", detectors.TypeURLCredential, "URL userinfo and sensitive parameters", []string{"global"}, WithURLs()},
+		{"dsn", detectors.TypeDBPassword, "Database connection string passwords", []string{"global"}, WithDSN()},
+		{"date", detectors.TypeDateOfBirth, "Dates of birth introduced by a keyword", []string{"global"}, WithDateOfBirth()},
+		{"coordinates", detectors.TypeCoordinates, "GPS coordinates", []string{"global"}, WithCoordinates()},
+		{"icd10", detectors.TypeICD10, "ICD-10 codes near patient context", []string{"global"}, WithICD10()},
+
+		{"br_cpf", detectors.TypeCPF, "Brazilian CPF", []string{"br", "latam"}, WithCPF()},
+		{"br_cnpj", detectors.TypeCNPJ, "Brazilian CNPJ", []string{"br", "latam"}, WithCNPJ()},
+		{"br_cep", detectors.TypeCEP, "Brazilian postal codes (CEP)", []string{"br", "latam"}, WithCEP()},
+		{"br_license_plate", detectors.TypeLicensePlate, "Brazilian license plates (Mercosul and legacy)", []string{"br", "latam"}, WithLicensePlate()},
+		{"br_renavam", detectors.TypeRENAVAM, "Brazilian vehicle registrations (RENAVAM)", []string{"br", "latam"}, WithRENAVAM()},
+		{"ar_cuit", detectors.TypeCUIT, "Argentine CUIT/CUIL", []string{"ar", "latam"}, WithCUIT()},
+		{"cl_rut", detectors.TypeRUT, "Chilean RUT", []string{"cl", "latam"}, WithRUT()},
+		{"mx_rfc", detectors.TypeRFC, "Mexican RFC", []string{"mx", "latam"}, WithRFC()},
+		{"mx_curp", detectors.TypeCURP, "Mexican CURP", []string{"mx", "latam"}, WithCURP()},
+		{"co_nit", detectors.TypeNIT, "Colombian NIT", []string{"co", "latam"}, WithNIT()},
+		{"pe_ruc", detectors.TypeRUC, "Peruvian RUC", []string{"pe", "latam"}, WithRUC()},
+
+		{"uk_nino", detectors.TypeNINO, "UK National Insurance numbers", []string{"uk", "eu"}, WithNINO()},
+		{"uk_nhs", detectors.TypeNHS, "UK NHS numbers", []string{"uk", "eu"}, WithNHS()},
+		{"uk_postcode", detectors.TypeUKPostcode, "UK postcodes", []string{"uk"}, WithUKPostcode()},
+		{"es_dni", detectors.TypeDNI, "Spanish DNI", []string{"es", "eu"}, WithDNI()},
+		{"es_nie", detectors.TypeNIE, "Spanish NIE", []string{"es", "eu"}, WithNIE()},
+		{"it_codice_fiscale", detectors.TypeCodiceFiscale, "Italian Codice Fiscale", []string{"it", "eu"}, WithCodiceFiscale()},
+		{"fr_nir", detectors.TypeNIR, "French NIR", []string{"fr", "eu"}, WithNIR()},
+		{"de_steuer_id", detectors.TypeSteuerID, "German Steuer-ID", []string{"de", "eu"}, WithSteuerID()},
+		{"nl_bsn", detectors.TypeBSN, "Dutch BSN", []string{"nl", "eu"}, WithBSN()},
+		{"pt_nif", detectors.TypeNIF, "Portuguese NIF", []string{"pt", "eu"}, WithNIF()},
+
+		{"in_aadhaar", detectors.TypeAadhaar, "Indian Aadhaar numbers", []string{"in", "apac"}, WithAadhaar()},
+		{"in_pan", detectors.TypePAN, "Indian PAN", []string{"in", "apac"}, WithPAN()},
+		{"sg_nric", detectors.TypeNRIC, "Singapore NRIC/FIN", []string{"sg", "apac"}, WithNRIC()},
+		{"au_tfn", detectors.TypeTFN, "Australian Tax File Numbers", []string{"au", "apac"}, WithTFN()},
+		{"au_medicare", detectors.TypeMedicare, "Australian Medicare numbers", []string{"au", "apac"}, WithMedicare()},
+
+		{"us_npi", detectors.TypeNPI, "US National Provider Identifiers", []string{"us"}, WithNPI()},
+		{"us_dea", detectors.TypeDEA, "US DEA registration numbers", []string{"us"}, WithDEA()},
+		{"us_zip", detectors.TypeZIPCode, "US ZIP and ZIP+4 codes", []string{"us"}, WithZIP()},
+		{"mrn", detectors.TypeMRN, "Medical record numbers", []string{"us"}, WithMRN()},
+		{"ca_postal_code", detectors.TypeCAPostalCode, "Canadian postal codes", []string{"ca"}, WithCAPostalCode()},
+		{"street_address", detectors.TypeStreetAddress, "Street addresses (Brazilian and English forms)", []string{"br", "us", "uk"}, WithStreetAddress()},
+		{"person_name", detectors.TypeName, "Person names (Brazilian, US and Hispanic dictionaries)", []string{"br", "us"}, WithNames()},
+	}
+
+	r := &Registry{entries: make(map[string]registration, len(builtins))}
+	for _, b := range builtins {
+		r.entries[b.name] = registration{
+			info: DetectorInfo{
+				Name:        b.name,
+				Type:        b.typ,
+				Locales:     b.locales,
+				Description: b.description,
+				Version:     builtinVersion,
+			},
+			enable: b.enable,
+		}
+	}
+	return r
+}
@@ -0,0 +1,137 @@
+package veil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/veil-services/veil-go/detectors"
+)
+
+func TestRegistry_Builtins(t *testing.T) {
+	infos := RegisteredDetectors()
+	if len(infos) < 50 {
+		t.Fatalf("expected the built-in detectors, got %d", len(infos))
+	}
+	for i, info := range infos {
+		if i > 0 && infos[i-1].Name >= info.Name {
+			t.Errorf("detectors not sorted: %s before %s", infos[i-1].Name, info.Name)
+		}
+		if info.Type == "" || len(info.Locales) == 0 || info.Description == "" || info.Version == "" {
+			t.Errorf("incomplete metadata: %+v", info)
+		}
+	}
+
+	// Every built-in enables a detector with its registered name
+	for _, info := range infos {
+		v, err := New(WithDetectors(info.Name))
+		if err != nil {
+			t.Fatalf("%s: %v", info.Name, err)
+		}
+		names := v.DetectorNames()
+		if len(names) != 1 || names[0] != info.Name {
+			t.Errorf("%s enabled %v", info.Name, names)
+		}
+	}
+}
+
+func TestVeil_WithDetectors(t *testing.T) {
+	v, err := New(WithDetectors("email", "br_cpf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	masked, _, _ := v.Mask("john@example.com 111.444.777-35 10.0.0.7")
+	if want := "<<EMAIL_1>> <<CPF_1>> 10.0.0.7"; masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	if _, err := New(WithDetectors("br_rg")); err == nil || !strings.Contains(err.Error(), "br_rg") {
+		t.Errorf("expected an unknown detector error, got %v", err)
+	}
+	if _, err := New(WithLocales("xx")); err == nil {
+		t.Error("expected an error for an unknown locale")
+	}
+}
+
+func TestVeil_WithoutDetector(t *testing.T) {
+	v, _ := New(WithEUIdentifiers(), WithURLs(), WithoutDetector("uk_nhs", "url"))
+	for _, name := range v.DetectorNames() {
+		if name == "uk_nhs" || name == "url" {
+			t.Errorf("%s should be disabled", name)
+		}
+	}
+	if len(v.DetectorNames()) == 0 {
+		t.Error("other EU detectors should stay enabled")
+	}
+}
+
+func TestVeil_WithLocales(t *testing.T) {
+	v, _ := New(WithLocales("BR"))
+	names := strings.Join(v.DetectorNames(), ",")
+	for _, want := range []string{"br_cpf", "br_cnpj", "br_cep", "br_license_plate", "br_renavam"} {
+		if !strings.Contains(names, want) {
+			t.Errorf("locale br should enable %s, got %s", want, names)
+		}
+	}
+	if strings.Contains(names, "email") || strings.Contains(names, "uk_nino") {
+		t.Errorf("locale br enabled unrelated detectors: %s", names)
+	}
+}
+
+type badgeDetector struct{}
+
+func (badgeDetector) Name() string { return "acme_badge" }
+
+func (badgeDetector) Scan(input string) []detectors.Match {
+	var matches []detectors.Match
+	for from := 0; ; {
+		i := strings.Index(input[from:], "BADGE-")
+		if i < 0 || from+i+10 > len(input) {
+			return matches
+		}
+		start := from + i
+		matches = append(matches, detectors.Match{
+			StartIndex: start,
+			EndIndex:   start + 10,
+			Value:      input[start : start+10],
+			Type:       "BADGE",
+			Score:      1.0,
+		})
+		from = start + 10
+	}
+}
+
+func TestRegistry_ThirdParty(t *testing.T) {
+	r := NewRegistry()
+	info := DetectorInfo{Name: "acme_badge", Type: "BADGE", Locales: []string{"ACME"}, Description: "Badge numbers", Version: "0.1"}
+	build := func() detectors.Detector { return badgeDetector{} }
+
+	if err := r.Register(info, build); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(info, build); err == nil {
+		t.Error("expected a duplicate registration error")
+	}
+	if err := r.Register(DetectorInfo{Name: "x"}, nil); err == nil {
+		t.Error("expected an error for a nil constructor")
+	}
+	if got, ok := r.Lookup("acme_badge"); !ok || got.Locales[0] != "acme" {
+		t.Errorf("unexpected lookup: %+v", got)
+	}
+
+	// Per-instance registries do not leak into the default one
+	if _, ok := LookupDetector("acme_badge"); ok {
+		t.Error("acme_badge leaked into the default registry")
+	}
+	if _, err := New(WithDetectors("acme_badge")); err == nil {
+		t.Error("expected acme_badge to be unknown to the default registry")
+	}
+
+	v, err := New(WithRegistry(r), WithDetectors("email"), WithLocales("acme"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	masked, _, _ := v.Mask("BADGE-1234 for john@example.com")
+	if want := "<<BADGE_1>> for <<EMAIL_1>>"; masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+}
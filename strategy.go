@@ -0,0 +1,76 @@
+package veil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"unicode"
+
+	"github.com/veil-services/veil-go/detectors"
+)
+
+// Strategy tells how the matches of a type are written in the masked text.
+type Strategy string
+
+const (
+	// StrategyToken replaces the value with a restorable <<TYPE_N>> token.
+	// It is the default.
+	StrategyToken Strategy = "token"
+
+	// StrategyRedact replaces the value with <<TYPE>>; it cannot be restored.
+	StrategyRedact Strategy = "redact"
+
+	// StrategyPartial keeps the last 4 letters or digits and separators,
+	// replacing the rest with '*' ("************1111").
+	StrategyPartial Strategy = "partial"
+
+	// StrategyHash replaces the value with <<TYPE_xxxxxxxx>>, a prefix of its
+	// SHA-256: the same value always gets the same token, across requests,
+	// but it cannot be restored.
+	StrategyHash Strategy = "hash"
+
+	// StrategyKeep leaves the value in clear text. Detect still reports it.
+	StrategyKeep Strategy = "keep"
+)
+
+// partialVisible is how many trailing letters or digits StrategyPartial
+// keeps.
+const partialVisible = 4
+
+func (s Strategy) valid() bool {
+	switch s {
+	case StrategyToken, StrategyRedact, StrategyPartial, StrategyHash, StrategyKeep:
+		return true
+	}
+	return false
+}
+
+// replacement returns the text written for m under a one-way strategy.
+func (s Strategy) replacement(m detectors.Match) string {
+	switch s {
+	case StrategyRedact:
+		return fmt.Sprintf("<<%s>>", m.Type)
+	case StrategyPartial:
+		return partialMask(m.Value)
+	case StrategyHash:
+		sum := sha256.Sum256([]byte(m.Value))
+		return fmt.Sprintf("<<%s_%s>>", m.Type, hex.EncodeToString(sum[:4]))
+	}
+	return m.Value
+}
+
+func partialMask(value string) string {
+	r := []rune(value)
+	visible := 0
+	for i := len(r) - 1; i >= 0; i-- {
+		if !unicode.IsLetter(r[i]) && !unicode.IsDigit(r[i]) {
+			continue
+		}
+		if visible < partialVisible {
+			visible++
+			continue
+		}
+		r[i] = '*'
+	}
+	return string(r)
+}
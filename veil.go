@@ -3,8 +3,10 @@ package veil
 import (
 	"errors"
 	"fmt"
-	"sort"
+	"maps"
+	"regexp"
 	"strings"
+	"sync/atomic"
 
 	"github.com/veil-services/veil-go/detectors"
 )
@@ -37,22 +39,176 @@ type Config struct {
 	MaskIP         bool
 	MaskUUID       bool
 
+	// Also find obfuscated emails and phones ("john at example dot com",
+	// "nove nove oito ...") when MaskEmail/MaskPhone are set
+	MaskObfuscated bool
+
+	// Also mask expiry dates and CVVs found next to a card number
+	MaskCardCompanions bool
+
+	// Device identifiers
+	MaskMAC      bool
+	MaskIMEI     bool
+	MaskDeviceID bool // Advertising IDs (IDFA, AAID)
+
+	// Latin American tax and population IDs
+	MaskCUIT bool // Argentina
+	MaskRUT  bool // Chile
+	MaskRFC  bool // Mexico
+	MaskCURP bool // Mexico
+	MaskNIT  bool // Colombia
+	MaskRUC  bool // Peru
+
+	// European national IDs
+	MaskNINO          bool // UK National Insurance
+	MaskNHS           bool // UK NHS number
+	MaskDNI           bool // Spain
+	MaskNIE           bool // Spain (foreigners)
+	MaskCodiceFiscale bool // Italy
+	MaskNIR           bool // France
+	MaskSteuerID      bool // Germany
+	MaskBSN           bool // Netherlands
+	MaskNIF           bool // Portugal
+
+	// Asia-Pacific identifiers
+	MaskAadhaar  bool // India
+	MaskPAN      bool // India
+	MaskNRIC     bool // Singapore NRIC/FIN
+	MaskTFN      bool // Australia
+	MaskMedicare bool // Australia
+
+	// Cryptocurrency wallet addresses
+	MaskBitcoin  bool
+	MaskEthereum bool
+	MaskSolana   bool
+	MaskTron     bool
+
+	// URL userinfo, sensitive query parameters and encoded PII in URLs
+	MaskURL   bool
+	URLParams []string // extra parameter names masked on top of detectors.DefaultURLParams
+
+	// Database connection strings: the password, optionally user and host
+	MaskDSN     bool
+	MaskDSNUser bool
+	MaskDSNHost bool
+
+	// Dates: birth dates by default, every date with MaskAllDates
+	MaskDOB      bool
+	MaskAllDates bool
+	DateOrder    detectors.DateOrder // how numeric dates like 03/04/1985 are read
+
+	// GPS coordinates
+	MaskCoordinates    bool
+	CoarsenCoordinates bool // round to 2 decimals instead of tokenizing
+
+	// Vehicle identifiers
+	MaskLicensePlate bool // Brazil (Mercosul and legacy)
+	MaskVIN          bool
+	MaskRENAVAM      bool // Brazil
+
+	// Health identifiers (US)
+	MaskNPI     bool
+	MaskDEA     bool
+	MaskMRN     bool
+	MRNPatterns []string // institution formats, see detectors.MRNDetector
+	MaskICD10   bool     // diagnosis codes in patient context
+
+	// Postal codes and street addresses
+	MaskCEP           bool // Brazil
+	MaskZIP           bool // US
+	MaskUKPostcode    bool
+	MaskCAPostalCode  bool // Canada
+	MaskStreetAddress bool
+
+	// Person names (dictionaries + honorific cues)
+	MaskName       bool
+	NameMinScore   float32  // zero means detectors.DefaultNameMinScore
+	NameFirstNames []string // extra first names
+	NameSurnames   []string // extra surnames
+
 	// List of custom detectors registered by the user
 	CustomDetectors []detectors.Detector
 
 	// If true, the same value always gets the same token within the same request
 	ConsistentTokenization bool
+
+	// Scan a normalized copy of the input (full-width and Arabic-Indic
+	// digits, NBSP, dash variants); matches still cover the original text
+	NormalizeUnicode bool
+
+	// Strip zero-width characters and fold homoglyphs before scanning; the
+	// whole obfuscated span is masked
+	FoldEvasions bool
+
+	// Values never masked (AnyType applies to every type) and values always
+	// masked as the given type, see WithAllow and WithDeny
+	Allow map[detectors.PIIType][]string
+	Deny  map[detectors.PIIType][]string
+
+	// Expressions for values never masked (matching the whole value) and
+	// always masked, see WithAllowPattern and WithDenyPattern
+	AllowPatterns map[detectors.PIIType][]*regexp.Regexp
+	DenyPatterns  map[detectors.PIIType][]*regexp.Regexp
+
+	// How each type is written in the masked text; StrategyToken by default
+	Strategies map[detectors.PIIType]Strategy
+
+	// Which finding wins when detections overlap, see WithOverlapStrategy;
+	// TypePriority orders types for OverlapPriority
+	Overlap      OverlapStrategy
+	TypePriority []detectors.PIIType
+
+	// Detectors and locales enabled by name, resolved through registry (the
+	// default registry when nil), and detectors removed by name
+	registry *Registry
+	enabled  []string
+	locales  []string
+	disabled map[string]bool
+
+	// err records the first invalid option; New returns it
+	err error
 }
 
-// Veil is the main engine.
+// Veil is the main engine. It is safe for concurrent use, including while
+// Reload swaps its configuration.
 type Veil struct {
+	state atomic.Pointer[engine]
+}
+
+// engine is an immutable snapshot of a configuration and its detectors.
+type engine struct {
 	config    Config
 	detectors []detectors.Detector
+	scanners  []detectors.Detector // detectors, consecutive standard ones run in one pass
+	lists     valueLists
+	overlaps  overlapResolver
 }
 
 // New initializes a new Veil instance with the provided options.
 // Example: veil.New(veil.WithEmail(), veil.WithCPF())
 func New(opts ...Option) (*Veil, error) {
+	e, err := newEngine(opts...)
+	if err != nil {
+		return nil, err
+	}
+	v := &Veil{}
+	v.state.Store(e)
+	return v, nil
+}
+
+// Reload atomically replaces the configuration of a running instance with
+// one built from opts (e.g. a reloaded config file). Calls in flight finish
+// with the previous configuration; on error the instance is left untouched.
+func (v *Veil) Reload(opts ...Option) error {
+	e, err := newEngine(opts...)
+	if err != nil {
+		return err
+	}
+	v.state.Store(e)
+	return nil
+}
+
+func newEngine(opts ...Option) (*engine, error) {
 	// Default configuration
 	cfg := Config{}
 
@@ -61,116 +217,333 @@ func New(opts ...Option) (*Veil, error) {
 		opt(&cfg)
 	}
 
-	v := &Veil{
+	// Enable detectors named through the registry
+	reg := cfg.registry
+	if reg == nil {
+		reg = defaultRegistry
+	}
+	for _, name := range cfg.enabled {
+		if err := reg.enable(&cfg, name); err != nil {
+			cfg.setErr(err)
+		}
+	}
+	for _, locale := range cfg.locales {
+		if err := reg.enableLocale(&cfg, locale); err != nil {
+			cfg.setErr(err)
+		}
+	}
+	if cfg.err != nil {
+		return nil, cfg.err
+	}
+
+	e := &engine{
 		config:    cfg,
 		detectors: make([]detectors.Detector, 0),
+		lists:     newValueLists(&cfg),
+		overlaps:  newOverlapResolver(cfg.Overlap, cfg.TypePriority),
 	}
 
 	// Register standard detectors based on flags
 	if cfg.MaskEmail {
-		v.detectors = append(v.detectors, detectors.NewEmailDetector())
+		e.detectors = append(e.detectors, &detectors.EmailDetector{
+			Obfuscated: cfg.MaskObfuscated,
+		})
 	}
 	if cfg.MaskCPF {
-		v.detectors = append(v.detectors, detectors.NewCPFDetector())
+		e.detectors = append(e.detectors, detectors.NewCPFDetector())
 	}
 	if cfg.MaskCNPJ {
-		v.detectors = append(v.detectors, detectors.NewCNPJDetector())
+		e.detectors = append(e.detectors, detectors.NewCNPJDetector())
 	}
 	if cfg.MaskCreditCard {
-		v.detectors = append(v.detectors, detectors.NewCreditCardDetector())
+		e.detectors = append(e.detectors, &detectors.CreditCardDetector{
+			Companions: cfg.MaskCardCompanions,
+		})
 	}
 	if cfg.MaskIP {
-		v.detectors = append(v.detectors, detectors.NewIPDetector())
+		e.detectors = append(e.detectors, detectors.NewIPDetector())
 	}
 	if cfg.MaskPhone {
-		v.detectors = append(v.detectors, detectors.NewPhoneDetector())
+		e.detectors = append(e.detectors, &detectors.PhoneDetector{
+			Obfuscated: cfg.MaskObfuscated,
+		})
 	}
 	if cfg.MaskUUID {
-		v.detectors = append(v.detectors, detectors.NewUUIDDetector())
+		e.detectors = append(e.detectors, detectors.NewUUIDDetector())
+	}
+	if cfg.MaskMAC {
+		e.detectors = append(e.detectors, detectors.NewMACDetector())
+	}
+	if cfg.MaskIMEI {
+		e.detectors = append(e.detectors, detectors.NewIMEIDetector())
+	}
+	if cfg.MaskDeviceID {
+		e.detectors = append(e.detectors, detectors.NewDeviceIDDetector())
+	}
+	if cfg.MaskCUIT {
+		e.detectors = append(e.detectors, detectors.NewCUITDetector())
+	}
+	if cfg.MaskRUT {
+		e.detectors = append(e.detectors, detectors.NewRUTDetector())
+	}
+	if cfg.MaskRFC {
+		e.detectors = append(e.detectors, detectors.NewRFCDetector())
+	}
+	if cfg.MaskCURP {
+		e.detectors = append(e.detectors, detectors.NewCURPDetector())
+	}
+	if cfg.MaskNIT {
+		e.detectors = append(e.detectors, detectors.NewNITDetector())
+	}
+	if cfg.MaskRUC {
+		e.detectors = append(e.detectors, detectors.NewRUCDetector())
+	}
+	if cfg.MaskNINO {
+		e.detectors = append(e.detectors, detectors.NewNINODetector())
+	}
+	if cfg.MaskNHS {
+		e.detectors = append(e.detectors, detectors.NewNHSDetector())
+	}
+	if cfg.MaskDNI {
+		e.detectors = append(e.detectors, detectors.NewDNIDetector())
+	}
+	if cfg.MaskNIE {
+		e.detectors = append(e.detectors, detectors.NewNIEDetector())
+	}
+	if cfg.MaskCodiceFiscale {
+		e.detectors = append(e.detectors, detectors.NewCodiceFiscaleDetector())
+	}
+	if cfg.MaskNIR {
+		e.detectors = append(e.detectors, detectors.NewNIRDetector())
+	}
+	if cfg.MaskSteuerID {
+		e.detectors = append(e.detectors, detectors.NewSteuerIDDetector())
+	}
+	if cfg.MaskBSN {
+		e.detectors = append(e.detectors, detectors.NewBSNDetector())
+	}
+	if cfg.MaskNIF {
+		e.detectors = append(e.detectors, detectors.NewNIFDetector())
+	}
+	if cfg.MaskAadhaar {
+		e.detectors = append(e.detectors, detectors.NewAadhaarDetector())
+	}
+	if cfg.MaskPAN {
+		e.detectors = append(e.detectors, detectors.NewPANDetector())
+	}
+	if cfg.MaskNRIC {
+		e.detectors = append(e.detectors, detectors.NewNRICDetector())
+	}
+	if cfg.MaskTFN {
+		e.detectors = append(e.detectors, detectors.NewTFNDetector())
+	}
+	if cfg.MaskMedicare {
+		e.detectors = append(e.detectors, detectors.NewMedicareDetector())
+	}
+	if cfg.MaskBitcoin {
+		e.detectors = append(e.detectors, detectors.NewBitcoinDetector())
+	}
+	if cfg.MaskEthereum {
+		e.detectors = append(e.detectors, detectors.NewEthereumDetector())
+	}
+	if cfg.MaskSolana {
+		e.detectors = append(e.detectors, detectors.NewSolanaDetector())
+	}
+	if cfg.MaskTron {
+		e.detectors = append(e.detectors, detectors.NewTronDetector())
+	}
+	if cfg.MaskDOB {
+		e.detectors = append(e.detectors, &detectors.DateDetector{
+			Order:    cfg.DateOrder,
+			AllDates: cfg.MaskAllDates,
+		})
+	}
+	if cfg.MaskCoordinates {
+		e.detectors = append(e.detectors, &detectors.CoordinatesDetector{
+			Coarsen: cfg.CoarsenCoordinates,
+		})
+	}
+	if cfg.MaskLicensePlate {
+		e.detectors = append(e.detectors, detectors.NewLicensePlateDetector())
+	}
+	if cfg.MaskVIN {
+		e.detectors = append(e.detectors, detectors.NewVINDetector())
+	}
+	if cfg.MaskRENAVAM {
+		e.detectors = append(e.detectors, detectors.NewRENAVAMDetector())
+	}
+	if cfg.MaskNPI {
+		e.detectors = append(e.detectors, detectors.NewNPIDetector())
+	}
+	if cfg.MaskDEA {
+		e.detectors = append(e.detectors, detectors.NewDEADetector())
+	}
+	if cfg.MaskMRN {
+		e.detectors = append(e.detectors, &detectors.MRNDetector{
+			Patterns: cfg.MRNPatterns,
+		})
+	}
+	if cfg.MaskICD10 {
+		e.detectors = append(e.detectors, detectors.NewICD10Detector())
+	}
+	if cfg.MaskCEP {
+		e.detectors = append(e.detectors, detectors.NewCEPDetector())
+	}
+	if cfg.MaskZIP {
+		e.detectors = append(e.detectors, detectors.NewZIPDetector())
+	}
+	if cfg.MaskUKPostcode {
+		e.detectors = append(e.detectors, detectors.NewUKPostcodeDetector())
+	}
+	if cfg.MaskCAPostalCode {
+		e.detectors = append(e.detectors, detectors.NewCAPostalCodeDetector())
+	}
+	if cfg.MaskStreetAddress {
+		e.detectors = append(e.detectors, detectors.NewStreetAddressDetector())
+	}
+	if cfg.MaskName {
+		e.detectors = append(e.detectors, &detectors.NameDetector{
+			MinScore:   cfg.NameMinScore,
+			FirstNames: cfg.NameFirstNames,
+			Surnames:   cfg.NameSurnames,
+		})
+	}
+	if cfg.MaskDSN {
+		e.detectors = append(e.detectors, &detectors.DSNDetector{
+			User: cfg.MaskDSNUser,
+			Host: cfg.MaskDSNHost,
+		})
 	}
 
 	// Register custom detectors
-	v.detectors = append(v.detectors, cfg.CustomDetectors...)
+	e.detectors = append(e.detectors, cfg.CustomDetectors...)
+
+	// Remove disabled detectors
+	if len(cfg.disabled) > 0 {
+		kept := e.detectors[:0]
+		for _, d := range e.detectors {
+			if !cfg.disabled[d.Name()] {
+				kept = append(kept, d)
+			}
+		}
+		e.detectors = kept
+	}
 
-	return v, nil
+	// The URL detector decodes URL components and runs the detectors above on them
+	if cfg.MaskURL && !cfg.disabled["url"] {
+		params := detectors.DefaultURLParams
+		if len(cfg.URLParams) > 0 {
+			params = append(append([]string(nil), params...), cfg.URLParams...)
+		}
+		e.detectors = append(e.detectors, &detectors.URLDetector{
+			Params:    params,
+			Detectors: append([]detectors.Detector(nil), e.detectors...),
+		})
+	}
+	e.scanners = detectors.Combine(e.detectors)
+
+	return e, nil
 }
 
 // Mask processes the text and returns the safe version + restoration context.
 func (v *Veil) Mask(input string) (string, *RestoreContext, error) {
+	ctx := &RestoreContext{Data: make(map[string]string)}
 	if input == "" {
-		return "", &RestoreContext{Data: make(map[string]string)}, nil
+		return "", ctx, nil
 	}
 
-	// 1. Scan: Collect all matches from all detectors
-	var allMatches []detectors.Match
-	for _, d := range v.detectors {
-		matches := d.Scan(input)
-		allMatches = append(allMatches, matches...)
-	}
-
-	// 2. Resolve conflicts (Greediest Match Wins)
-	if len(allMatches) == 0 {
-		return input, &RestoreContext{Data: make(map[string]string)}, nil
+	// 1-2. Scan and resolve conflicts, in a pooled buffer
+	e := v.state.Load()
+	b := getMaskBuffer()
+	defer putMaskBuffer(b)
+	b.matches = e.scanInto(b.matches, input)
+	if len(b.matches) == 0 {
+		return input, ctx, nil
 	}
 
-	finalMatches := resolveOverlaps(allMatches)
-
 	// 3. Tokenization and String Construction
-	var sb strings.Builder
-	// Pre-allocate builder size to avoid reallocations (heuristic: input size)
-	sb.Grow(len(input))
+	// Pre-allocate the output to avoid reallocations (heuristic: input size)
+	out := e.mask(make([]byte, 0, len(input)+len(input)/4), input, b, ctx, true)
+	return bytesToString(out), ctx, nil
+}
 
-	ctx := &RestoreContext{
-		Data: make(map[string]string),
+// DetectorNames returns the names of the active detectors, in scan order.
+func (v *Veil) DetectorNames() []string {
+	e := v.state.Load()
+	names := make([]string, len(e.detectors))
+	for i, d := range e.detectors {
+		names[i] = d.Name()
 	}
+	return names
+}
 
-	typeCounters := make(map[detectors.PIIType]int)
-	valueCache := make(map[string]string)
-
-	lastIndex := 0
+// Detect returns the PII occurrences found in input, after overlap
+// resolution and sorted by position, without masking anything.
+// Detector-specific details such as the card brand are in Match.Metadata,
+// as are overlap decisions (MetaOverlap, MetaOverlapped, MetaTypes).
+func (v *Veil) Detect(input string) []detectors.Match {
+	if input == "" {
+		return nil
+	}
+	matches := v.state.Load().scanInto(nil, input)
+	if len(matches) == 0 {
+		return nil
+	}
+	// Detectors share their metadata maps; the caller gets its own
+	for i := range matches {
+		if matches[i].Metadata != nil {
+			matches[i].Metadata = maps.Clone(matches[i].Metadata)
+		}
+	}
+	return matches
+}
 
-	// Sort matches by start index for linear construction
-	sort.Slice(finalMatches, func(i, j int) bool {
-		return finalMatches[i].StartIndex < finalMatches[j].StartIndex
-	})
+// scanInto runs every detector over input and returns the non-overlapping
+// matches ordered by StartIndex, reusing the memory of buf. Detectors
+// implementing detectors.AppendScanner append to it directly.
+func (e *engine) scanInto(buf []detectors.Match, input string) []detectors.Match {
+	var mode detectors.NormalizeMode
+	if e.config.NormalizeUnicode {
+		mode |= detectors.NormalizeEquivalents
+	}
+	if e.config.FoldEvasions {
+		mode |= detectors.NormalizeInvisible | detectors.NormalizeConfusables
+	}
 
-	for _, m := range finalMatches {
-		// Add non-masked text before the match
-		if m.StartIndex > lastIndex {
-			sb.WriteString(input[lastIndex:m.StartIndex])
+	text := input
+	var norm *detectors.Normalized
+	if mode != 0 {
+		if norm = detectors.NormalizeWith(input, mode); norm.Changed() {
+			text = norm.Text
 		}
+	}
 
-		// Determine token
-		var token string
-		if v.config.ConsistentTokenization {
-			if existingToken, exists := valueCache[m.Value]; exists {
-				token = existingToken
-			}
+	// 1. Scan: Collect all matches from all detectors
+	allMatches := buf[:0]
+	textBytes := stringToBytes(text) // read-only view for ScanAppend
+	for _, d := range e.scanners {
+		if as, ok := d.(detectors.AppendScanner); ok {
+			allMatches = as.ScanAppend(allMatches, textBytes)
+			continue
 		}
+		allMatches = append(allMatches, d.Scan(text)...)
+	}
 
-		if token == "" {
-			typeCounters[m.Type]++
-			count := typeCounters[m.Type]
-			token = fmt.Sprintf("<<%s_%d>>", string(m.Type), count)
-
-			if v.config.ConsistentTokenization {
-				valueCache[m.Value] = token
-			}
+	// Map matches on the normalized text back to the original one
+	if text != input {
+		for i := range allMatches {
+			m := &allMatches[i]
+			m.StartIndex, m.EndIndex = norm.Span(m.StartIndex, m.EndIndex)
+			m.Value = input[m.StartIndex:m.EndIndex]
 		}
-
-		// Save to context and replace
-		ctx.Data[token] = m.Value
-		sb.WriteString(token)
-
-		lastIndex = m.EndIndex
 	}
 
-	// Add remaining string
-	if lastIndex < len(input) {
-		sb.WriteString(input[lastIndex:])
-	}
+	// Allow and deny lists
+	allMatches = e.lists.apply(input, allMatches)
 
-	return sb.String(), ctx, nil
+	// 2. Resolve conflicts, see OverlapStrategy
+	// Sorted by start index for linear construction
+	return e.overlaps.resolve(input, allMatches)
 }
 
 // Restore takes the masked text and the original context to retrieve data.
@@ -223,47 +596,3 @@ func (v *Veil) Sanitize(input interface{}) interface{} {
 	masked, _, _ := v.Mask(strInput)
 	return masked
 }
-
-// resolveOverlaps removes matches that are contained within larger matches or conflict.
-// Strategy: Prioritize the largest match (Greediest). On size tie, prioritize Score.
-func resolveOverlaps(matches []detectors.Match) []detectors.Match {
-	if len(matches) <= 1 {
-		return matches
-	}
-
-	// Sort by StartIndex asc, and then by Length desc (to pick the longest first)
-	sort.Slice(matches, func(i, j int) bool {
-		if matches[i].StartIndex != matches[j].StartIndex {
-			return matches[i].StartIndex < matches[j].StartIndex
-		}
-		// If start at same position, longest comes first
-		lenI := matches[i].EndIndex - matches[i].StartIndex
-		lenJ := matches[j].EndIndex - matches[j].StartIndex
-		return lenI > lenJ
-	})
-
-	var result []detectors.Match
-	var lastMatch detectors.Match
-
-	for i, m := range matches {
-		if i == 0 {
-			result = append(result, m)
-			lastMatch = m
-			continue
-		}
-
-		// Check overlap
-		// If current match starts before previous match ends, there is a conflict.
-		if m.StartIndex < lastMatch.EndIndex {
-			// Conflict detected.
-			// Since we sorted by length, 'lastMatch' is likely the "best" one.
-			// We ignore 'm' as it is "inside" or conflicting with a prioritized match.
-			continue
-		}
-
-		result = append(result, m)
-		lastMatch = m
-	}
-
-	return result
-}
@@ -1,13 +1,18 @@
 package veil
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
+	"os"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/veil-services/veil-go/detectors"
 )
 
 func TestVeil_MaskRestore(t *testing.T) {
@@ -195,6 +200,499 @@ func TestConcurrency_Massive(t *testing.T) {
 	t.Logf("Processed %d concurrent requests in %v", routines, time.Since(start))
 }
 
+func TestVeil_DetectCardBrand(t *testing.T) {
+	v, _ := New(WithCreditCard())
+
+	matches := v.Detect("Visa 4111 1111 1111 1111 and Amex 371449635398431")
+	if len(matches) != 2 {
+		t.Fatalf("Expected 2 findings, got %d", len(matches))
+	}
+	if matches[0].Metadata["brand"] != "visa" || matches[1].Metadata["brand"] != "amex" {
+		t.Errorf("Unexpected brands: %v, %v", matches[0].Metadata, matches[1].Metadata)
+	}
+}
+
+func TestVeil_CardCompanions(t *testing.T) {
+	v, _ := New(WithCardCompanions())
+
+	input := "Card 4111 1111 1111 1111 exp 12/27 cvv 123"
+	masked, ctx, _ := v.Mask(input)
+
+	want := "Card <<CREDIT_CARD_1>> exp <<CARD_EXPIRY_1>> cvv <<CVV_1>>"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore mismatch.\nWant: %s\nGot:  %s", input, restored)
+	}
+}
+
+func TestVeil_IMEINotDoubleMatched(t *testing.T) {
+	v, _ := New(WithCreditCard(), WithIMEI())
+
+	input := "IMEI 35-209900-176148-1, IMEISV 3520990017614808"
+	matches := v.Detect(input)
+	if len(matches) != 2 {
+		t.Fatalf("Expected 2 findings, got %d: %+v", len(matches), matches)
+	}
+	for _, m := range matches {
+		if m.Type != detectors.TypeIMEI {
+			t.Errorf("Expected IMEI, got %s for %q", m.Type, m.Value)
+		}
+	}
+}
+
+func TestVeil_CardAfterIMEILabel(t *testing.T) {
+	v, _ := New(WithCreditCard(), WithIMEI())
+
+	masked, _, _ := v.Mask("imei: 4111111111111111")
+	if want := "imei: <<CREDIT_CARD_1>>"; masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	// A Luhn-valid IMEISV in the JCB range is both; the IMEI wins the overlap
+	matches := v.Detect("IMEISV 3540990017614804")
+	if len(matches) != 1 || matches[0].Type != detectors.TypeIMEI {
+		t.Errorf("Expected one IMEI finding, got %+v", matches)
+	}
+}
+
+func TestVeil_DetectMetadataOwned(t *testing.T) {
+	v, _ := New(WithCreditCard(), WithDeviceIDs())
+	input := "Card 4111 1111 1111 1111, idfa=6D92078A-8246-4BA4-AE5B-76104861E7DC"
+
+	for _, m := range v.Detect(input) {
+		m.Metadata["brand"] = "changed"
+		m.Metadata["kind"] = "changed"
+	}
+	for _, m := range v.Detect(input) {
+		if m.Metadata["brand"] == "changed" || m.Metadata["kind"] == "changed" {
+			t.Errorf("metadata leaked between calls: %+v", m)
+		}
+	}
+}
+
+func TestVeil_URLKeepsStructure(t *testing.T) {
+	v, _ := New(WithEmail(), WithURLParams("customer"))
+
+	input := "Fetch https://svc:pw@api.example.com/u/ana%40example.com?customer=77&page=2 failed"
+	masked, ctx, _ := v.Mask(input)
+
+	want := "Fetch https://<<URL_CREDENTIAL_1>>@api.example.com/u/<<EMAIL_1>>?customer=<<URL_PARAM_1>>&page=2 failed"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore mismatch.\nWant: %s\nGot:  %s", input, restored)
+	}
+}
+
+func TestVeil_DSNPasswordOnly(t *testing.T) {
+	v, _ := New(WithDSNUsers())
+
+	input := "connect: dial postgres://app:s3cr3t@db:5432/x refused; fallback Server=db2;User Id=sa;Password=Pa55;"
+	masked, ctx, _ := v.Mask(input)
+
+	want := "connect: dial postgres://<<DB_USER_1>>:<<DB_PASSWORD_1>>@db:5432/x refused; fallback Server=db2;User Id=<<DB_USER_2>>;Password=<<DB_PASSWORD_2>>;"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore mismatch.\nWant: %s\nGot:  %s", input, restored)
+	}
+}
+
+func TestVeil_DateLocale(t *testing.T) {
+	input := "DOB 04/05/1985, DOB 5 May 1985"
+
+	for _, locale := range []string{"en-US", "pt-BR"} {
+		v, _ := New(WithDateOfBirth(), WithDateLocale(locale))
+		masked, _, _ := v.Mask(input)
+		want := "DOB <<DATE_OF_BIRTH_1>>, DOB <<DATE_OF_BIRTH_2>>"
+		if masked != want {
+			t.Errorf("%s: unexpected mask.\nWant: %s\nGot:  %s", locale, want, masked)
+		}
+	}
+
+	v, _ := New(WithDateOfBirth())
+	if masked, _, _ := v.Mask("Shipped 04/05/2024"); masked != "Shipped 04/05/2024" {
+		t.Errorf("plain date should not be masked by default, got %s", masked)
+	}
+}
+
+func TestVeil_CEPNotPartOfPhone(t *testing.T) {
+	v, _ := New(WithPhone(), WithAddresses())
+
+	input := "Tel +55 11 98765-4321, Rua Augusta, 1500 - CEP 01310-200"
+	masked, _, _ := v.Mask(input)
+
+	want := "Tel <<PHONE_1>>, <<STREET_ADDRESS_1>> - CEP <<CEP_1>>"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+}
+
+func TestVeil_Sanitize_Names(t *testing.T) {
+	v, _ := New(WithNames(), WithCreditCard())
+
+	got := v.Sanitize(`{"user": "Maria Oliveira", "card": "4111 1111 1111 1111"}`)
+	want := `{"user": "<<NAME_1>>", "card": "<<CREDIT_CARD_1>>"}`
+	if got != want {
+		t.Errorf("Unexpected sanitize.\nWant: %s\nGot:  %s", want, got)
+	}
+}
+
+func TestVeil_CoarseCoordinates(t *testing.T) {
+	v, _ := New(WithEmail(), WithCoarseCoordinates())
+
+	input := "Driver ana@example.com delivered at -23.550520, -46.633308"
+	masked, ctx, _ := v.Mask(input)
+
+	want := "Driver <<EMAIL_1>> delivered at -23.55, -46.63"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+	if len(ctx.Data) != 1 {
+		t.Errorf("Coarsened coordinates must not enter the restore context: %v", ctx.Data)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != "Driver ana@example.com delivered at -23.55, -46.63" {
+		t.Errorf("Unexpected restore: %s", restored)
+	}
+}
+
+func TestVeil_HIPAASafeHarbor(t *testing.T) {
+	v, _ := New(WithHIPAASafeHarbor(), WithMRN("MR-#######"))
+
+	input := "Patient John Smith, DOB 03/12/1985, MRN 00123456, visit MR-7654321 on 2024-05-02. Call +1 415 555 0132"
+	masked, ctx, _ := v.Mask(input)
+
+	want := "Patient <<NAME_1>>, DOB <<DATE_OF_BIRTH_1>>, MRN <<MRN_1>>, visit <<MRN_2>> on <<DATE_1>>. Call <<PHONE_1>>"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore failed: %s", restored)
+	}
+}
+
+func TestVeil_ObfuscatedContacts(t *testing.T) {
+	input := "Reach me at john at example dot com or nove nove oito sete seis cinco quatro três"
+
+	plain, _ := New(WithEmail(), WithPhone())
+	if masked, _, _ := plain.Mask(input); masked != input {
+		t.Errorf("Obfuscated contacts must be opt-in, got: %s", masked)
+	}
+
+	v, _ := New(WithObfuscatedContacts())
+	masked, ctx, _ := v.Mask(input)
+
+	want := "Reach me at <<EMAIL_1>> or <<PHONE_1>>"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore failed: %s", restored)
+	}
+}
+
+func TestVeil_UnicodeNormalization(t *testing.T) {
+	input := "CPF １１１.４４４.７７７-３５, fone +55\u00a011\u00a099999\u20119999, email joão@empresa.com.br"
+
+	plain, _ := New(WithCPF(), WithPhone(), WithEmail())
+	if masked, _, _ := plain.Mask(input); masked != "CPF １１１.４４４.７７７-３５, fone +55\u00a011\u00a099999\u20119999, email <<EMAIL_1>>" {
+		t.Errorf("Without normalization only the email should be masked, got: %s", masked)
+	}
+
+	v, _ := New(WithCPF(), WithPhone(), WithEmail(), WithUnicodeNormalization())
+	masked, ctx, _ := v.Mask(input)
+
+	want := "CPF <<CPF_1>>, fone <<PHONE_1>>, email <<EMAIL_1>>"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+	if ctx.Data["<<CPF_1>>"] != "１１１.４４４.７７７-３５" {
+		t.Errorf("Context must hold the original value, got %q", ctx.Data["<<CPF_1>>"])
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore failed: %s", restored)
+	}
+}
+
+func TestVeil_EvasionResistance(t *testing.T) {
+	input := "card 4111\u200b1111\u200b1111\u200b1111, mail john@ex\u0430mple.com, cpf 111.\u00ad444.777-35"
+
+	plain, _ := New(WithEmail(), WithCreditCard(), WithCPF())
+	if masked, _, _ := plain.Mask(input); strings.Contains(masked, "<<CREDIT_CARD_1>>") || strings.Contains(masked, "<<CPF_1>>") {
+		t.Errorf("Evasion resistance must be opt-in, got: %s", masked)
+	}
+
+	v, _ := New(WithEmail(), WithCreditCard(), WithCPF(), WithEvasionResistance())
+	masked, ctx, _ := v.Mask(input)
+
+	want := "card <<CREDIT_CARD_1>>, mail <<EMAIL_1>>, cpf <<CPF_1>>"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %q\nGot:  %q", want, masked)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore failed: %q", restored)
+	}
+}
+
+func TestVeil_PatternDetectors(t *testing.T) {
+	v, err := New(
+		WithEmail(),
+		WithPattern("account_id", detectors.NewPIIType("account id"), `ACC-\d{4}-\d{6}`),
+		WithPattern("employee_id", detectors.NewPIIType("employee_id"), `\d{5}`,
+			detectors.PatternKeywords("employee", "matrícula")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := "Account ACC-2024-000123 owned by employee 12345 (bob@example.com), order 55555"
+	masked, _, _ := v.Mask(input)
+
+	want := "Account <<ACCOUNT_ID_1>> owned by employee <<EMPLOYEE_ID_1>> (<<EMAIL_1>>), order 55555"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	if _, err := New(WithPattern("broken", detectors.TypeCustom, `ACC-(`)); err == nil {
+		t.Error("Expected New to fail on an invalid pattern")
+	}
+}
+
+func TestVeil_Dictionary(t *testing.T) {
+	v, _ := New(
+		WithEmail(),
+		WithDictionary("customers", detectors.NewPIIType("customer"), []detectors.DictionaryEntry{
+			{Term: "Acme Corp"},
+			{Term: "João Silva", Type: detectors.NewPIIType("vip")},
+		}),
+	)
+
+	input := "Joao Silva from ACME CORP (joao@acme.com) asked about Acme Corporation"
+	masked, ctx, _ := v.Mask(input)
+
+	want := "<<VIP_1>> from <<CUSTOMER_1>> (<<EMAIL_1>>) asked about Acme Corporation"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore failed: %s", restored)
+	}
+}
+
+func TestVeil_AllowDenyLists(t *testing.T) {
+	v, err := New(
+		WithEmail(), WithIP(),
+		WithAllow(detectors.TypeEmail, "support@acme.com"),
+		WithAllow(AnyType, "127.0.0.1"),
+		WithDeny("PROJECT", "Falcon"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := "Mail support@acme.com or john@acme.com from 127.0.0.1 or 10.0.0.7 about Falcon, not Falconry"
+	masked, ctx, _ := v.Mask(input)
+
+	want := "Mail support@acme.com or <<EMAIL_1>> from 127.0.0.1 or <<IP_1>> about <<PROJECT_1>>, not Falconry"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	restored, _ := v.Restore(masked, ctx)
+	if restored != input {
+		t.Errorf("Restore failed: %s", restored)
+	}
+
+	if _, err := New(WithDeny(AnyType, "x")); err == nil {
+		t.Error("expected an error for a deny list without a type")
+	}
+}
+
+func TestVeil_NormalizedLists(t *testing.T) {
+	v, err := New(
+		WithEmail(), WithIP(), WithCPF(), WithCreditCard(),
+		WithAllow(detectors.TypeCreditCard, "4242 4242 4242 4242"),
+		WithAllow(detectors.TypeCPF, "11144477735"),
+		WithAllow(detectors.TypeEmail, "Support@Acme.com"),
+		WithAllow(detectors.TypeIP, "10.0.0.0/8"),
+		WithAllowPattern(detectors.TypeEmail, `.+@example\.com`),
+		WithDeny(detectors.TypeCPF, "529.982.247-25"),
+		WithDeny(detectors.TypeIP, "192.168.7.0/24"),
+		WithDenyPattern("TICKET", `INC-\d{5}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Card Digits", "test card 4242-4242-4242-4242", "test card 4242-4242-4242-4242"},
+		{"Card Other", "card 4111 1111 1111 1111", "card <<CREDIT_CARD_1>>"},
+		{"CPF Punctuated", "CPF 111.444.777-35", "CPF 111.444.777-35"},
+		{"Email Case", "mail support@acme.com", "mail support@acme.com"},
+		{"Email Pattern", "mail qa@example.com or qa@example.com.br", "mail qa@example.com or <<EMAIL_1>>"},
+		{"CIDR Allow", "from 10.1.2.3 and 11.1.2.3", "from 10.1.2.3 and <<IP_1>>"},
+		{"Deny Digits", "doc 52998224725 and 529.982.247-25", "doc <<CPF_1>> and <<CPF_2>>"},
+		{"Deny Inside Number", "id 9529982247250", "id 9529982247250"},
+		{"Deny Pattern", "see INC-12345", "see <<TICKET_1>>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			masked, _, _ := v.Mask(tt.input)
+			if masked != tt.expected {
+				t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", tt.expected, masked)
+			}
+		})
+	}
+
+	// Denied IP ranges apply without the IP detector
+	deny, _ := New(WithDeny(detectors.TypeIP, "192.168.7.0/24"))
+	if masked, _, _ := deny.Mask("hosts 192.168.7.20 and 192.168.8.20"); masked != "hosts <<IP_1>> and 192.168.8.20" {
+		t.Errorf("Unexpected mask: %s", masked)
+	}
+
+	if _, err := New(WithAllowPattern(detectors.TypeEmail, "a(")); err == nil {
+		t.Error("expected an error for an invalid allow pattern")
+	}
+}
+
+func TestVeil_Strategies(t *testing.T) {
+	v, err := New(
+		WithEmail(), WithCPF(), WithCreditCard(), WithIP(),
+		WithStrategy(detectors.TypeCreditCard, StrategyPartial),
+		WithStrategy(detectors.TypeCPF, StrategyRedact),
+		WithStrategy(detectors.TypeEmail, StrategyHash),
+		WithStrategy(detectors.TypeIP, StrategyKeep),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := "Card 4111 1111 1111 1111, CPF 111.444.777-35, mail john@example.com, ip 10.0.0.7"
+	masked, _, _ := v.Mask(input)
+
+	want := "Card **** **** **** 1111, CPF <<CPF>>, mail <<EMAIL_855f96e9>>, ip 10.0.0.7"
+	if masked != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	// Hashes are stable across calls
+	again, _, _ := v.Mask("john@example.com")
+	if again != "<<EMAIL_855f96e9>>" {
+		t.Errorf("hash token changed: %s", again)
+	}
+
+	if _, err := New(WithStrategy(detectors.TypeEmail, "scramble")); err == nil {
+		t.Error("expected an error for an unknown strategy")
+	}
+}
+
+func TestVeil_MaskAppend(t *testing.T) {
+	v, _ := New(WithEmail(), WithCPF(), WithCreditCard(), WithConsistentTokenization(true),
+		WithStrategy(detectors.TypeCPF, StrategyRedact))
+
+	src := []byte("From ana@example.com to ana@example.com, card 4111 1111 1111 1111, CPF 111.444.777-35")
+	want, _, _ := v.Mask(string(src))
+
+	got := v.MaskAppend([]byte("log: "), src)
+	if string(got) != "log: "+want {
+		t.Errorf("Unexpected mask.\nWant: log: %s\nGot:  %s", want, got)
+	}
+	if got := v.MaskAppend(nil, []byte("no pii here")); string(got) != "no pii here" {
+		t.Errorf("Text without PII must be copied as is, got %q", got)
+	}
+
+	if raceEnabled {
+		return
+	}
+	dst := make([]byte, 0, 256)
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = v.MaskAppend(dst[:0], src)
+	})
+	if allocs != 0 {
+		t.Errorf("MaskAppend allocated %v times per call", allocs)
+	}
+}
+
+func TestVeil_MaskBytes(t *testing.T) {
+	v, _ := New(WithEmail(), WithCPF())
+
+	src := []byte("User ana@example.com, CPF 111.444.777-35")
+	masked, ctx, err := v.MaskBytes(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "User <<EMAIL_1>>, CPF <<CPF_1>>"; string(masked) != want {
+		t.Errorf("Unexpected mask.\nWant: %s\nGot:  %s", want, masked)
+	}
+
+	// The context must not share memory with a reused input buffer
+	original := string(src)
+	for i := range src {
+		src[i] = 'x'
+	}
+	restored, _ := v.Restore(string(masked), ctx)
+	if restored != original {
+		t.Errorf("Restore after reusing the input.\nWant: %s\nGot:  %s", original, restored)
+	}
+}
+
+func TestVeil_CombinedScan(t *testing.T) {
+	data, err := os.ReadFile("testdata/corpus.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var corpus []struct {
+		Input string `json:"input"`
+	}
+	if err := json.Unmarshal(data, &corpus); err != nil {
+		t.Fatal(err)
+	}
+
+	v, _ := New(WithEmail(), WithCPF(), WithCNPJ(), WithCreditCard(), WithIP(), WithPhone(),
+		WithUUID(), WithMAC())
+	combined := v.state.Load()
+	if len(combined.scanners) >= len(combined.detectors) {
+		t.Fatalf("standard detectors were not combined: %d scanners", len(combined.scanners))
+	}
+	separate := *combined
+	separate.scanners = separate.detectors
+
+	for _, c := range corpus {
+		got := combined.scanInto(nil, c.Input)
+		want := separate.scanInto(nil, c.Input)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("input %q:\n combined %v\n separate %v", c.Input, got, want)
+		}
+	}
+}
+
 func TestErrors(t *testing.T) {
 	v, _ := New()
 	_, err := v.Restore("text", nil)
@@ -223,3 +721,39 @@ func BenchmarkParallelMask(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkMaskBytes(b *testing.B) {
+	v, _ := New(WithEmail(), WithCPF(), WithCreditCard())
+	input := []byte("User john.doe@company.com requested transaction with card 4111 1111 1111 1111 (CPF 111.444.777-35)")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = v.MaskBytes(input)
+	}
+}
+
+func BenchmarkMaskAppend(b *testing.B) {
+	v, _ := New(WithEmail(), WithCPF(), WithCreditCard())
+	input := []byte("User john.doe@company.com requested transaction with card 4111 1111 1111 1111 (CPF 111.444.777-35)")
+	dst := make([]byte, 0, 2*len(input))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = v.MaskAppend(dst[:0], input)
+	}
+}
+
+func BenchmarkParallelMaskAppend(b *testing.B) {
+	v, _ := New(WithEmail(), WithCPF(), WithCreditCard())
+	input := []byte("User john.doe@company.com requested transaction with card 4111 1111 1111 1111 (CPF 111.444.777-35)")
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		dst := make([]byte, 0, 2*len(input))
+		for pb.Next() {
+			dst = v.MaskAppend(dst[:0], input)
+		}
+	})
+}